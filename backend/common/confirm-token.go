@@ -0,0 +1,69 @@
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// how long the user has to confirm a destructive operation
+const ConfirmTokenTTL = 5 * time.Minute
+
+func signConfirmToken(subject string, expires int64) (string, error) {
+
+	ssk, err := GetSSK()
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, ssk)
+	fmt.Fprintf(mac, "%s|%d", subject, expires)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// create a token confirming the operation on the subject (ex. "namespace/payments"),
+// the token is signed with server secret key so nothing has to be stored
+func NewConfirmToken(subject string) (string, time.Time, error) {
+
+	expires := time.Now().Add(ConfirmTokenTTL)
+	signature, err := signConfirmToken(subject, expires.Unix())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return fmt.Sprintf("%d.%s", expires.Unix(), signature), expires, nil
+}
+
+// check if token was issued for the subject and didn't expire yet
+func CheckConfirmToken(subject string, token string) error {
+
+	expiresStr, signature, found := strings.Cut(token, ".")
+	if !found {
+		return errors.New("malformed confirmation token")
+	}
+
+	expires, err := strconv.ParseInt(expiresStr, 10, 64)
+	if err != nil {
+		return errors.New("malformed confirmation token")
+	}
+
+	expected, err := signConfirmToken(subject, expires)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return errors.New("invalid confirmation token")
+	}
+
+	if time.Now().Unix() > expires {
+		return errors.New("confirmation token expired")
+	}
+
+	return nil
+}
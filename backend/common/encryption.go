@@ -0,0 +1,78 @@
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+)
+
+// AES-256 key derived from server secret key, the secret key itself
+// is used for signing so it's not used directly
+func encryptionKey() ([]byte, error) {
+
+	ssk, err := GetSSK()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, ssk)
+	mac.Write([]byte("kubedash-encryption"))
+
+	return mac.Sum(nil), nil
+}
+
+func newGCM() (cipher.AEAD, error) {
+
+	key, err := encryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// encrypt data stored at rest (ex. secrets in the database) with AES-GCM,
+// random nonce is prepended to the result
+func Encrypt(plaintext []byte) ([]byte, error) {
+
+	gcm, err := newGCM()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func Decrypt(ciphertext []byte) ([]byte, error) {
+
+	gcm, err := newGCM()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("malformed encrypted data")
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, errors.New("unable to decrypt data, was the server secret key changed?")
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,293 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	autoscalingapiv2 "k8s.io/api/autoscaling/v2"
+	coreapiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// value of the quantity, empty when it's not set
+func quantityString(quantity *resource.Quantity) string {
+
+	if quantity == nil {
+		return ""
+	}
+
+	return quantity.String()
+}
+
+func hpaTargetMetric(spec *autoscalingapiv2.MetricSpec) models.HPAMetricModel {
+
+	var name string
+	var target autoscalingapiv2.MetricTarget
+	switch spec.Type {
+	case autoscalingapiv2.ResourceMetricSourceType:
+		name, target = string(spec.Resource.Name), spec.Resource.Target
+	case autoscalingapiv2.ContainerResourceMetricSourceType:
+		name, target = string(spec.ContainerResource.Name), spec.ContainerResource.Target
+	case autoscalingapiv2.PodsMetricSourceType:
+		name, target = spec.Pods.Metric.Name, spec.Pods.Target
+	case autoscalingapiv2.ObjectMetricSourceType:
+		name, target = spec.Object.Metric.Name, spec.Object.Target
+	case autoscalingapiv2.ExternalMetricSourceType:
+		name, target = spec.External.Metric.Name, spec.External.Target
+	}
+
+	return models.HPAMetricModel{
+		Type:         string(spec.Type),
+		Name:         name,
+		Utilization:  target.AverageUtilization,
+		AverageValue: quantityString(target.AverageValue),
+		Value:        quantityString(target.Value),
+	}
+}
+
+func hpaCurrentMetric(status *autoscalingapiv2.MetricStatus) models.HPAMetricModel {
+
+	var name string
+	var current autoscalingapiv2.MetricValueStatus
+	switch status.Type {
+	case autoscalingapiv2.ResourceMetricSourceType:
+		name, current = string(status.Resource.Name), status.Resource.Current
+	case autoscalingapiv2.ContainerResourceMetricSourceType:
+		name, current = string(status.ContainerResource.Name), status.ContainerResource.Current
+	case autoscalingapiv2.PodsMetricSourceType:
+		name, current = status.Pods.Metric.Name, status.Pods.Current
+	case autoscalingapiv2.ObjectMetricSourceType:
+		name, current = status.Object.Metric.Name, status.Object.Current
+	case autoscalingapiv2.ExternalMetricSourceType:
+		name, current = status.External.Metric.Name, status.External.Current
+	}
+
+	return models.HPAMetricModel{
+		Type:         string(status.Type),
+		Name:         name,
+		Utilization:  current.AverageUtilization,
+		AverageValue: quantityString(current.AverageValue),
+		Value:        quantityString(current.Value),
+	}
+}
+
+func hpaSummary(
+	hpa *autoscalingapiv2.HorizontalPodAutoscaler,
+) models.ListHPAsResponseModelHPA {
+
+	summary := models.ListHPAsResponseModelHPA{
+		Name:            hpa.Name,
+		Namespace:       hpa.Namespace,
+		TargetKind:      hpa.Spec.ScaleTargetRef.Kind,
+		TargetName:      hpa.Spec.ScaleTargetRef.Name,
+		MinReplicas:     1,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		Targets:         []models.HPAMetricModel{},
+		Current:         []models.HPAMetricModel{},
+	}
+	if hpa.Spec.MinReplicas != nil {
+		summary.MinReplicas = *hpa.Spec.MinReplicas
+	}
+	if hpa.Status.LastScaleTime != nil {
+		summary.LastScaleTime = hpa.Status.LastScaleTime.UTC().Format(time.RFC3339)
+	}
+	for i := range hpa.Spec.Metrics {
+		summary.Targets = append(summary.Targets, hpaTargetMetric(&hpa.Spec.Metrics[i]))
+	}
+	for i := range hpa.Status.CurrentMetrics {
+		summary.Current = append(summary.Current, hpaCurrentMetric(&hpa.Status.CurrentMetrics[i]))
+	}
+
+	return summary
+}
+
+func ListHPAs(
+	clientset kubernetes.Interface,
+	req *models.ListHPAsRequestModel,
+) (models.ListHPAsResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListHPAsResponseModel{}, err
+	}
+	hpaList, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListHPAsResponseModel{}, listError(err)
+	}
+	err = sortObjects(hpaList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListHPAsResponseModel{}, err
+	}
+
+	resp := models.ListHPAsResponseModel{}
+	resp.HPAs = []models.ListHPAsResponseModelHPA{}
+	for i := range hpaList.Items {
+		resp.HPAs = append(resp.HPAs, hpaSummary(&hpaList.Items[i]))
+	}
+	resp.Pagination = pagination(hpaList.ListMeta, len(resp.HPAs))
+
+	return resp, nil
+
+}
+
+// set target, replica bounds and utilization metrics of the HPA spec
+// from the request, metrics not managed by the request are dropped
+func applyHPASpec(
+	spec *autoscalingapiv2.HorizontalPodAutoscalerSpec,
+	req *models.HPAModel,
+) error {
+
+	minReplicas := req.MinReplicas
+	if minReplicas == 0 {
+		minReplicas = 1
+	}
+	if req.MaxReplicas < minReplicas {
+		return fmt.Errorf(
+			"%w: max_replicas must not be lower than min_replicas", ErrInvalidRequest,
+		)
+	}
+	if req.CPUUtilization == 0 && req.MemoryUtilization == 0 {
+		return fmt.Errorf(
+			"%w: cpu_utilization or memory_utilization is required", ErrInvalidRequest,
+		)
+	}
+
+	spec.ScaleTargetRef = autoscalingapiv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       req.TargetKind,
+		Name:       req.TargetName,
+	}
+	spec.MinReplicas = &minReplicas
+	spec.MaxReplicas = req.MaxReplicas
+
+	spec.Metrics = []autoscalingapiv2.MetricSpec{}
+	for _, target := range []struct {
+		name        coreapiv1.ResourceName
+		utilization int32
+	}{
+		{coreapiv1.ResourceCPU, req.CPUUtilization},
+		{coreapiv1.ResourceMemory, req.MemoryUtilization},
+	} {
+		if target.utilization == 0 {
+			continue
+		}
+		spec.Metrics = append(spec.Metrics, autoscalingapiv2.MetricSpec{
+			Type: autoscalingapiv2.ResourceMetricSourceType,
+			Resource: &autoscalingapiv2.ResourceMetricSource{
+				Name: target.name,
+				Target: autoscalingapiv2.MetricTarget{
+					Type:               autoscalingapiv2.UtilizationMetricType,
+					AverageUtilization: &target.utilization,
+				},
+			},
+		})
+	}
+
+	return nil
+}
+
+func CreateHPA(
+	clientset kubernetes.Interface,
+	req *models.HPAModel,
+) error {
+
+	hpa := &autoscalingapiv2.HorizontalPodAutoscaler{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+	}
+	err := applyHPASpec(&hpa.Spec, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.AutoscalingV2().HorizontalPodAutoscalers(req.Namespace).Create(
+		context.TODO(), hpa, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdateHPA(
+	clientset kubernetes.Interface,
+	req *models.HPAModel,
+) error {
+
+	hpa, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = applyHPASpec(&hpa.Spec, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.AutoscalingV2().HorizontalPodAutoscalers(req.Namespace).Update(
+		context.TODO(), hpa, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+func DeleteHPA(
+	clientset kubernetes.Interface,
+	req *models.DeleteHPARequestModel,
+) error {
+
+	err := clientset.AutoscalingV2().HorizontalPodAutoscalers(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
+
+// warnings for setting replicas of the workload by hand, the HPA
+// scaling it overrides the count on its next sync, the warnings are only
+// advisory so autoscalers which can't be listed are logged and skipped
+func manualScalingWarnings(
+	clientset kubernetes.Interface, namespace string, kind string, name string,
+) []string {
+
+	hpaList, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(
+		context.TODO(), metaapiv1.ListOptions{},
+	)
+	if err != nil {
+		log.Printf(
+			"unable to list horizontal pod autoscalers of %s %s/%s: %v",
+			kind, namespace, name, err,
+		)
+		return nil
+	}
+
+	warnings := []string{}
+	for _, hpa := range hpaList.Items {
+		target := hpa.Spec.ScaleTargetRef
+		if target.Kind != kind || target.Name != name {
+			continue
+		}
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"%s %s is scaled by horizontal pod autoscaler %s (%d-%d replicas), "+
+				"the replica count will be overridden",
+			kind, name, hpa.Name, minReplicas, hpa.Spec.MaxReplicas,
+		))
+	}
+
+	return warnings
+}
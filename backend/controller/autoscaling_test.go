@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsapiv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func TestUpdateDeploymentWithoutAutoscalerAccess(t *testing.T) {
+
+	replicas := int32(1)
+	clientset := fakekubernetes.NewSimpleClientset(&appsapiv1.Deployment{
+		ObjectMeta: metaapiv1.ObjectMeta{Name: "nginx", Namespace: "default"},
+		Spec:       appsapiv1.DeploymentSpec{Replicas: &replicas},
+	})
+	clientset.PrependReactor("list", "horizontalpodautoscalers",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(
+				schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}, "", nil,
+			)
+		},
+	)
+
+	req := &models.UpdateDeploymentRequestModel{Namespace: "default", Name: "nginx", Replicas: 3}
+
+	// the warnings are advisory, the update itself succeeded
+	warnings, err := UpdateDeployment(clientset, req)
+	if err != nil || len(warnings) != 0 {
+		t.Fatalf("expected update without warnings, got %v: %v", warnings, err)
+	}
+	deployment, err := clientset.AppsV1().Deployments("default").Get(
+		context.TODO(), "nginx", metaapiv1.GetOptions{},
+	)
+	if err != nil || *deployment.Spec.Replicas != 3 {
+		t.Fatalf("expected 3 replicas, got %v: %v", deployment, err)
+	}
+
+	diff, err := DiffUpdateDeployment(clientset, req)
+	if err != nil || len(diff.Warnings) != 0 {
+		t.Fatalf("expected diff without warnings, got %v: %v", diff.Warnings, err)
+	}
+}
@@ -0,0 +1,289 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	appsapiv1 "k8s.io/api/apps/v1"
+	coreapiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+var ErrCacheNotSynced = errors.New("resource cache is not synced yet, try again later")
+
+// how often informers replay the whole cache to their handlers
+const cacheResyncPeriod = 10 * time.Minute
+
+// in-memory copy of cluster objects kept up to date with watches,
+// read endpoints use it instead of listing from the API server
+type ResourceCache struct {
+	Factory informers.SharedInformerFactory
+	synced  atomic.Bool
+	watch   *watchHub
+	// informers the cache has to sync before it's ready
+	informers []cache.SharedIndexInformer
+}
+
+func NewResourceCache(clientset kubernetes.Interface) *ResourceCache {
+
+	factory := informers.NewSharedInformerFactory(clientset, cacheResyncPeriod)
+
+	rc := &ResourceCache{Factory: factory, watch: newWatchHub()}
+
+	// informers have to be requested before the factory is started
+	for _, kind := range searchableKinds {
+		rc.informers = append(rc.informers, kind.informer(factory))
+	}
+	for i := range watchableResources {
+		informer := watchableResources[i].informer(factory)
+		rc.watch.register(&watchableResources[i], informer)
+		rc.informers = append(rc.informers, informer)
+	}
+	// replica sets resolve the workloads of pods in collected metrics
+	rc.informers = append(rc.informers, factory.Apps().V1().ReplicaSets().Informer())
+
+	return rc
+}
+
+// start watching and mark the cache synced once the initial lists finish
+func (rc *ResourceCache) Start(stopCh <-chan struct{}) {
+
+	rc.Factory.Start(stopCh)
+
+	go func() {
+		syncedFuncs := []cache.InformerSynced{}
+		for _, informer := range rc.informers {
+			syncedFuncs = append(syncedFuncs, informer.HasSynced)
+		}
+
+		if !cache.WaitForCacheSync(stopCh, syncedFuncs...) {
+			log.Println("resource cache stopped before it synced")
+			return
+		}
+		rc.synced.Store(true)
+		log.Println("resource cache synced")
+	}()
+}
+
+func (rc *ResourceCache) HasSynced() bool {
+	return rc.synced.Load()
+}
+
+// whether reads can be served from the cache, nil cache
+// always reads directly from the API server
+func (rc *ResourceCache) useCache(fresh bool) bool {
+	return rc != nil && !fresh && rc.HasSynced()
+}
+
+// cached objects come in random order, sort them the same way the API server does
+func sortByNamespaceName[T metaapiv1.Object](objects []T) {
+
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].GetNamespace() != objects[j].GetNamespace() {
+			return objects[i].GetNamespace() < objects[j].GetNamespace()
+		}
+		return objects[i].GetName() < objects[j].GetName()
+	})
+}
+
+// pods of the namespace (all when empty) matching the options (nil for all)
+// from the cache, or directly from the API server when fresh data is requested,
+// the cache isn't synced or the options need the server (see cacheableOptions)
+func listPods(
+	clientset kubernetes.Interface, rc *ResourceCache,
+	namespace string, opts *models.ListOptionsModel, fresh bool,
+) ([]coreapiv1.Pod, metaapiv1.ListMeta, error) {
+
+	options, err := listOptions(opts)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+
+	if !rc.useCache(fresh) || !cacheableOptions(opts) {
+		pods, err := clientset.CoreV1().Pods(namespace).List(context.TODO(), options)
+		if err != nil {
+			return nil, metaapiv1.ListMeta{}, listError(err)
+		}
+		return pods.Items, pods.ListMeta, nil
+	}
+
+	selector, err := labels.Parse(options.LabelSelector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	cached, err := rc.Factory.Core().V1().Pods().Lister().Pods(namespace).List(selector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	sortByNamespaceName(cached)
+
+	// cached objects are shared and must not be modified, callers get copies
+	pods := make([]coreapiv1.Pod, 0, len(cached))
+	for _, pod := range cached {
+		pods = append(pods, *pod)
+	}
+
+	return pods, metaapiv1.ListMeta{}, nil
+}
+
+func listDeployments(
+	clientset kubernetes.Interface, rc *ResourceCache,
+	namespace string, opts *models.ListOptionsModel, fresh bool,
+) ([]appsapiv1.Deployment, metaapiv1.ListMeta, error) {
+
+	options, err := listOptions(opts)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+
+	if !rc.useCache(fresh) || !cacheableOptions(opts) {
+		deployments, err := clientset.AppsV1().Deployments(namespace).List(context.TODO(), options)
+		if err != nil {
+			return nil, metaapiv1.ListMeta{}, listError(err)
+		}
+		return deployments.Items, deployments.ListMeta, nil
+	}
+
+	selector, err := labels.Parse(options.LabelSelector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	cached, err := rc.Factory.Apps().V1().Deployments().Lister().Deployments(namespace).List(selector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	sortByNamespaceName(cached)
+
+	deployments := make([]appsapiv1.Deployment, 0, len(cached))
+	for _, deployment := range cached {
+		deployments = append(deployments, *deployment)
+	}
+
+	return deployments, metaapiv1.ListMeta{}, nil
+}
+
+func listReplicaSets(
+	clientset kubernetes.Interface, rc *ResourceCache,
+	namespace string, opts *models.ListOptionsModel, fresh bool,
+) ([]appsapiv1.ReplicaSet, metaapiv1.ListMeta, error) {
+
+	options, err := listOptions(opts)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+
+	if !rc.useCache(fresh) || !cacheableOptions(opts) {
+		replicaSets, err := clientset.AppsV1().ReplicaSets(namespace).List(context.TODO(), options)
+		if err != nil {
+			return nil, metaapiv1.ListMeta{}, listError(err)
+		}
+		return replicaSets.Items, replicaSets.ListMeta, nil
+	}
+
+	selector, err := labels.Parse(options.LabelSelector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	cached, err := rc.Factory.Apps().V1().ReplicaSets().Lister().ReplicaSets(namespace).List(selector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	sortByNamespaceName(cached)
+
+	replicaSets := make([]appsapiv1.ReplicaSet, 0, len(cached))
+	for _, replicaSet := range cached {
+		replicaSets = append(replicaSets, *replicaSet)
+	}
+
+	return replicaSets, metaapiv1.ListMeta{}, nil
+}
+
+func listServices(
+	clientset kubernetes.Interface, rc *ResourceCache,
+	namespace string, opts *models.ListOptionsModel, fresh bool,
+) ([]coreapiv1.Service, metaapiv1.ListMeta, error) {
+
+	options, err := listOptions(opts)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+
+	if !rc.useCache(fresh) || !cacheableOptions(opts) {
+		services, err := clientset.CoreV1().Services(namespace).List(context.TODO(), options)
+		if err != nil {
+			return nil, metaapiv1.ListMeta{}, listError(err)
+		}
+		return services.Items, services.ListMeta, nil
+	}
+
+	selector, err := labels.Parse(options.LabelSelector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	cached, err := rc.Factory.Core().V1().Services().Lister().Services(namespace).List(selector)
+	if err != nil {
+		return nil, metaapiv1.ListMeta{}, err
+	}
+	sortByNamespaceName(cached)
+
+	services := make([]coreapiv1.Service, 0, len(cached))
+	for _, service := range cached {
+		services = append(services, *service)
+	}
+
+	return services, metaapiv1.ListMeta{}, nil
+}
+
+// pods with the name, namespace can be empty to look in all of them
+func findPods(
+	clientset kubernetes.Interface, rc *ResourceCache, namespace string, name string, fresh bool,
+) ([]coreapiv1.Pod, error) {
+
+	if !rc.useCache(fresh) {
+		pods, err := clientset.CoreV1().Pods(namespace).List(
+			context.TODO(), metaapiv1.ListOptions{
+				FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return pods.Items, nil
+	}
+
+	if namespace != "" {
+		pod, err := rc.Factory.Core().V1().Pods().Lister().Pods(namespace).Get(name)
+		if apierrors.IsNotFound(err) {
+			return []coreapiv1.Pod{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []coreapiv1.Pod{*pod}, nil
+	}
+
+	pods, _, err := listPods(clientset, rc, "", nil, fresh)
+	if err != nil {
+		return nil, err
+	}
+	found := []coreapiv1.Pod{}
+	for _, pod := range pods {
+		if pod.Name == name {
+			found = append(found, pod)
+		}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,565 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	authorizationapiv1 "k8s.io/api/authorization/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	metricsv "k8s.io/metrics/pkg/client/clientset/versioned"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// how long the cluster registry waits for a cluster to answer
+const clusterCheckTimeout = 5 * time.Second
+
+// name of the cluster the server runs in when no kubeconfig is given
+const InClusterName = "in-cluster"
+
+var ErrClusterNotFound = errors.New("cluster not found")
+
+// returned (wrapped) when the impersonated user isn't allowed to do
+// what the request needs, handlers report it as forbidden
+var ErrForbidden = errors.New("forbidden")
+
+// clients of one cluster (kubeconfig context) the server talks to
+type Cluster struct {
+	// name of the cluster used in the cluster parameter of the API
+	Name string
+	// kubeconfig context of the cluster
+	Context string
+	// address of the API server
+	Server     string
+	Clientset  kubernetes.Interface
+	Metricsset metricsv.Interface
+	Dynamic    *DynamicClient
+	// lists of object metadata without the rest of the objects (ex. data
+	// of secrets), nil for clusters created from clients
+	Metadata metadata.Interface
+	// cache serving reads, nil for impersonated clusters so reads
+	// go through the API server with permissions of the user
+	Cache *ResourceCache
+	// user the requests are made as, empty when the server
+	// uses its own identity
+	User string
+	// cache of the cluster even when the requests are impersonated,
+	// used only for the user's view checked with access reviews
+	shared *ResourceCache
+	// uncached discovery with short timeout for health checks
+	checks discovery.DiscoveryInterface
+	// requests to the API server proxy, nil for clusters without config
+	proxy  *proxyClient
+	config *rest.Config
+}
+
+// all clusters the server was configured with
+type ClusterRegistry struct {
+	// name of the cluster used when the request doesn't name one
+	Default string
+	// make requests as the logged in user with impersonation headers
+	// so the cluster RBAC decides what the user can do
+	Impersonate bool
+	// groups sent with every impersonated user
+	ImpersonateGroups []string
+	// proxy requests of every logged in user with the server's own
+	// credentials, without impersonation the proxy is disabled otherwise
+	AllowProxy bool
+	clusters   map[string]*Cluster
+	// clients of impersonated users by cluster and user name
+	impersonated   map[[2]string]*Cluster
+	impersonatedMu sync.Mutex
+}
+
+// cluster served with the given clients (ex. fake clientsets), the resource
+// cache is created but not started, such cluster can't be impersonated
+func NewCluster(
+	name string,
+	clientset kubernetes.Interface,
+	metricsset metricsv.Interface,
+	dynamicclient *DynamicClient,
+) *Cluster {
+
+	cache := NewResourceCache(clientset)
+
+	return &Cluster{
+		Name:       name,
+		Clientset:  clientset,
+		Metricsset: metricsset,
+		Dynamic:    dynamicclient,
+		Cache:      cache,
+		shared:     cache,
+		checks:     clientset.Discovery(),
+	}
+}
+
+func newCluster(name string, contextName string, config *rest.Config) (*Cluster, error) {
+
+	clientset, metricsset, err := NewClientSet(config)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicclient, err := NewDynamicClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	checkConfig := rest.CopyConfig(config)
+	checkConfig.Timeout = clusterCheckTimeout
+	checks, err := discovery.NewDiscoveryClientForConfig(checkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery client: %v", err)
+	}
+
+	metadataclient, err := metadata.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating metadata client: %v", err)
+	}
+
+	proxy, err := newProxyClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := NewCluster(name, clientset, metricsset, dynamicclient)
+	cluster.Metadata = metadataclient
+	cluster.Context = contextName
+	cluster.Server = config.Host
+	cluster.checks = checks
+	cluster.proxy = proxy
+	cluster.config = config
+
+	return cluster, nil
+}
+
+// clients of the cluster making requests as the user, discovery is
+// shared with the cluster as it's the same for everyone, everything
+// returning objects must use the clients made here
+func (cluster *Cluster) impersonate(user string, groups []string) (*Cluster, error) {
+
+	if cluster.config == nil {
+		return nil, fmt.Errorf("cluster %s doesn't support impersonation", cluster.Name)
+	}
+
+	config := rest.CopyConfig(cluster.config)
+	config.Impersonate = rest.ImpersonationConfig{UserName: user, Groups: groups}
+
+	clientset, metricsset, err := NewClientSet(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %v", err)
+	}
+
+	restClient, err := newRESTClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataclient, err := metadata.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating metadata client: %v", err)
+	}
+
+	proxy, err := newProxyClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cluster{
+		Name:       cluster.Name,
+		Context:    cluster.Context,
+		Server:     cluster.Server,
+		Clientset:  clientset,
+		Metricsset: metricsset,
+		Dynamic: &DynamicClient{
+			Client:    client,
+			Discovery: cluster.Dynamic.Discovery,
+			Mapper:    cluster.Dynamic.Mapper,
+			REST:      restClient,
+		},
+		Metadata: metadataclient,
+		User:     user,
+		shared:   cluster.shared,
+		checks:   cluster.checks,
+		proxy:    proxy,
+		config:   config,
+	}, nil
+}
+
+// kubeconfig with relative paths (ex. certificate files) resolved
+// against its own directory
+func loadKubeconfig(path string) (*clientcmdapi.Config, error) {
+
+	config, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	err = clientcmd.ResolveLocalPaths(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func newClusterRegistry() *ClusterRegistry {
+	return &ClusterRegistry{
+		clusters:     map[string]*Cluster{},
+		impersonated: map[[2]string]*Cluster{},
+	}
+}
+
+func (r *ClusterRegistry) register(cluster *Cluster) error {
+
+	if _, found := r.clusters[cluster.Name]; found {
+		return fmt.Errorf("cluster %s is defined more than once", cluster.Name)
+	}
+	r.clusters[cluster.Name] = cluster
+
+	return nil
+}
+
+// use defaultCluster when given, otherwise keep the current default
+// when it exists or take the first cluster
+func (r *ClusterRegistry) setDefault(defaultCluster string) error {
+
+	if defaultCluster != "" {
+		r.Default = defaultCluster
+	}
+	if _, found := r.clusters[r.Default]; !found {
+		if defaultCluster != "" {
+			return fmt.Errorf("%w: %s", ErrClusterNotFound, defaultCluster)
+		}
+		r.Default = r.Clusters()[0].Name
+	}
+
+	return nil
+}
+
+func (r *ClusterRegistry) add(
+	name string, kubeconfig *clientcmdapi.Config, contextName string,
+) error {
+
+	config, err := clientcmd.NewNonInteractiveClientConfig(
+		*kubeconfig, contextName, &clientcmd.ConfigOverrides{}, nil,
+	).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("error building config of cluster %s: %v", name, err)
+	}
+
+	cluster, err := newCluster(name, contextName, config)
+	if err != nil {
+		return err
+	}
+
+	return r.register(cluster)
+}
+
+// load clusters from all contexts of the kubeconfig, path can also be
+// a directory of kubeconfigs, then clusters are named by the files
+// (<file>/<context> for files with more contexts), the current context
+// is the default cluster unless defaultCluster is given, empty path
+// uses the service account of the pod the server runs in
+func NewClusterRegistry(path string, defaultCluster string) (*ClusterRegistry, error) {
+
+	if path == "" {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, err
+		}
+		cluster, err := newCluster(InClusterName, "", config)
+		if err != nil {
+			return nil, err
+		}
+		return NewClusterRegistryFromClusters(defaultCluster, cluster)
+	}
+
+	r := newClusterRegistry()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		kubeconfig, err := loadKubeconfig(path)
+		if err != nil {
+			return nil, err
+		}
+		for contextName := range kubeconfig.Contexts {
+			err = r.add(contextName, kubeconfig, contextName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		r.Default = kubeconfig.CurrentContext
+	} else {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		// entries are sorted by name, the first file sets the default cluster
+		for _, entry := range entries {
+			if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			kubeconfig, err := loadKubeconfig(filepath.Join(path, entry.Name()))
+			if err != nil || len(kubeconfig.Contexts) == 0 {
+				log.Printf("skipping %s, it's not a kubeconfig", entry.Name())
+				continue
+			}
+
+			base := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+			for contextName := range kubeconfig.Contexts {
+				name := base
+				if len(kubeconfig.Contexts) > 1 {
+					name = base + "/" + contextName
+				}
+				err = r.add(name, kubeconfig, contextName)
+				if err != nil {
+					return nil, err
+				}
+				if r.Default == "" && contextName == kubeconfig.CurrentContext {
+					r.Default = name
+				}
+			}
+		}
+	}
+
+	if len(r.clusters) == 0 {
+		return nil, fmt.Errorf("no kubeconfig contexts found in %s", path)
+	}
+
+	// without current context the first cluster is the default
+	err = r.setDefault(defaultCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// registry of already created clusters, the first one is the default
+// unless defaultCluster is given
+func NewClusterRegistryFromClusters(
+	defaultCluster string, clusters ...*Cluster,
+) (*ClusterRegistry, error) {
+
+	if len(clusters) == 0 {
+		return nil, errors.New("no clusters given")
+	}
+
+	r := newClusterRegistry()
+	for _, cluster := range clusters {
+		err := r.register(cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+	r.Default = clusters[0].Name
+
+	err := r.setDefault(defaultCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// all clusters in alphabetical order
+func (r *ClusterRegistry) Clusters() []*Cluster {
+
+	clusters := make([]*Cluster, 0, len(r.clusters))
+	for _, cluster := range r.clusters {
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	return clusters
+}
+
+// cluster by the name, default cluster when the name is empty
+func (r *ClusterRegistry) Get(name string) (*Cluster, error) {
+
+	if name == "" {
+		name = r.Default
+	}
+	cluster, found := r.clusters[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+	}
+
+	return cluster, nil
+}
+
+// the cluster with requests made as the user when the registry
+// impersonates, clients are created once for every user
+func (r *ClusterRegistry) As(cluster *Cluster, user string) (*Cluster, error) {
+
+	if !r.Impersonate {
+		return cluster, nil
+	}
+	if user == "" {
+		return nil, fmt.Errorf("%w: impersonation needs a logged in user", ErrForbidden)
+	}
+
+	r.impersonatedMu.Lock()
+	defer r.impersonatedMu.Unlock()
+
+	key := [2]string{cluster.Name, user}
+	if impersonated, found := r.impersonated[key]; found {
+		return impersonated, nil
+	}
+
+	impersonated, err := cluster.impersonate(user, r.ImpersonateGroups)
+	if err != nil {
+		return nil, err
+	}
+	r.impersonated[key] = impersonated
+
+	return impersonated, nil
+}
+
+// whether the user of the cluster can list the resource in the namespace
+// (cluster wide when empty), always true without impersonation
+func canList(cluster *Cluster, group string, resource string, namespace string) (bool, error) {
+	return canAccess(cluster, "list", group, resource, "", namespace, "")
+}
+
+// data the server collects with its own identity is returned only to users
+// who can list the resource it comes from, ErrForbidden otherwise
+func requireList(cluster *Cluster, group string, resource string, namespace string) error {
+
+	allowed, err := canList(cluster, group, resource, namespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		if group != "" {
+			resource += "." + group
+		}
+		return fmt.Errorf("%w: user can't list %s", ErrForbidden, resource)
+	}
+
+	return nil
+}
+
+// whether the user of the cluster can do the verb on the resource (or its
+// subresource) in the namespace, named object when name isn't empty,
+// always true without impersonation
+func canAccess(
+	cluster *Cluster,
+	verb string,
+	group string,
+	resource string,
+	subresource string,
+	namespace string,
+	name string,
+) (bool, error) {
+
+	if cluster.User == "" {
+		return true, nil
+	}
+
+	review, err := cluster.Clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(
+		context.TODO(),
+		&authorizationapiv1.SelfSubjectAccessReview{
+			Spec: authorizationapiv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationapiv1.ResourceAttributes{
+					Verb:        verb,
+					Group:       group,
+					Resource:    resource,
+					Subresource: subresource,
+					Namespace:   namespace,
+					Name:        name,
+				},
+			},
+		},
+		metaapiv1.CreateOptions{},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
+
+func checkCluster(cluster *Cluster) models.ClusterModel {
+
+	status := models.ClusterModel{
+		Name:    cluster.Name,
+		Context: cluster.Context,
+		Server:  cluster.Server,
+	}
+
+	version, err := cluster.checks.ServerVersion()
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Reachable = true
+	status.Version = version.GitVersion
+
+	// discovery of fake clientsets has no REST client to check with
+	restClient := cluster.checks.RESTClient()
+	if restClient == nil {
+		status.Error = "health check is not supported"
+		return status
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clusterCheckTimeout)
+	defer cancel()
+	_, err = restClient.Get().AbsPath("/readyz").DoRaw(ctx)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Healthy = true
+
+	return status
+}
+
+// all clusters with their version and health, clusters are checked
+// at the same time so unreachable ones don't slow down the rest
+func ListClusters(r *ClusterRegistry) models.ListClustersResponseModel {
+
+	clusters := r.Clusters()
+
+	resp := models.ListClustersResponseModel{}
+	resp.Clusters = make([]models.ClusterModel, len(clusters))
+
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp.Clusters[i] = checkCluster(cluster)
+			resp.Clusters[i].Default = cluster.Name == r.Default
+		}()
+	}
+	wg.Wait()
+
+	return resp
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	// kubernetes api -> coreapiv1, metaapiv1, don't confuse with internal apiv1
@@ -15,13 +17,17 @@ import (
 	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/rest"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	metricsv "k8s.io/metrics/pkg/client/clientset/versioned"
 
 	"github.com/kube-dash/kube-dash-backend/models"
 )
 
+// returned (wrapped) when the request refers to objects or values the cluster
+// can't accept, handlers report it as bad request instead of internal error
+var ErrInvalidRequest = errors.New("invalid request")
+
 // validate CPURequest/CPULimit
 func validateCPU(request string) bool {
 
@@ -46,12 +52,7 @@ func validateMemory(request string) bool {
 	return match
 }
 
-func NewClientSet(kubeconfigPath string) (*kubernetes.Clientset, *metricsv.Clientset, error) {
-
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error building kubeconfig: %v", err)
-	}
+func NewClientSet(config *rest.Config) (*kubernetes.Clientset, *metricsv.Clientset, error) {
 
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
@@ -66,52 +67,104 @@ func NewClientSet(kubeconfigPath string) (*kubernetes.Clientset, *metricsv.Clien
 	return clientset, metricsset, nil
 }
 
-func ListPodsV1(clientset *kubernetes.Clientset, namespace string) (*coreapiv1.PodList, error) {
+func ListPodsV1(
+	clientset kubernetes.Interface,
+	rc *ResourceCache,
+	req *models.ListPodsV1RequestModel,
+) (*coreapiv1.PodList, error) {
 
-	pods, err := clientset.CoreV1().Pods(namespace).List(
-		context.TODO(), metaapiv1.ListOptions{},
-	)
+	pods, listMeta, err := listPods(clientset, rc, req.Namespace, &req.ListOptionsModel, req.Fresh)
+	if err != nil {
+		return nil, err
+	}
+	err = sortObjects(pods, &req.ListOptionsModel, podStatus, podRestarts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coreapiv1.PodList{ListMeta: listMeta, Items: pods}, nil
+
+}
 
-	return pods, err
+func podStatus(pod *coreapiv1.Pod) string {
+	return string(pod.Status.Phase)
+}
+
+// restarts of app containers, as kubectl shows them
+func podRestarts(pod *coreapiv1.Pod) int64 {
 
+	restarts := int64(0)
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts += int64(status.RestartCount)
+	}
+
+	return restarts
+}
+
+// summarized pod as returned by list and watch endpoints
+func podSummary(poddata *coreapiv1.Pod) models.ListPodsV2ResponseModelPod {
+
+	currentPod := models.ListPodsV2ResponseModelPod{}
+	currentPod.Name = poddata.ObjectMeta.Name
+	currentPod.Namespace = poddata.ObjectMeta.Namespace
+	currentPod.Status = string(poddata.Status.Phase)
+
+	return currentPod
 }
 
 func ListPodsV2(
-	clientset *kubernetes.Clientset,
+	clientset kubernetes.Interface,
+	rc *ResourceCache,
 	req *models.ListPodsV2RequestModel,
 ) (models.ListPodsV2ResponseModel, error) {
 
-	pods, err := ListPodsV1(clientset, req.Namespace)
+	pods, listMeta, err := listPods(clientset, rc, req.Namespace, &req.ListOptionsModel, req.Fresh)
+	if err != nil {
+		return models.ListPodsV2ResponseModel{}, err
+	}
+	err = sortObjects(pods, &req.ListOptionsModel, podStatus, podRestarts)
+	if err != nil {
+		return models.ListPodsV2ResponseModel{}, err
+	}
 
 	resp := models.ListPodsV2ResponseModel{}
 	resp.Pods = []models.ListPodsV2ResponseModelPod{}
-	for _, poddata := range pods.Items {
-		currentPod := models.ListPodsV2ResponseModelPod{}
-		currentPod.Name = poddata.ObjectMeta.Name
-		currentPod.Namespace = poddata.ObjectMeta.Namespace
-		currentPod.Status = string(poddata.Status.Phase)
-		resp.Pods = append(resp.Pods, currentPod)
+	for i := range pods {
+		resp.Pods = append(resp.Pods, podSummary(&pods[i]))
 	}
+	resp.Pagination = pagination(listMeta, len(resp.Pods))
 
-	return resp, err
+	return resp, nil
 
 }
 
 func ListContainers(
-	clientset *kubernetes.Clientset,
+	clientset kubernetes.Interface,
+	rc *ResourceCache,
 	req *models.ListContainersRequestModel,
 ) (models.ListContainersReponseModel, error) {
 
-	pods, err := ListPodsV1(clientset, req.Namespace)
+	var pods []coreapiv1.Pod
+	var listMeta metaapiv1.ListMeta
+	var err error
+	if req.PodName != "" {
+		pods, err = findPods(clientset, rc, req.Namespace, req.PodName, req.Fresh)
+	} else {
+		pods, listMeta, err = listPods(
+			clientset, rc, req.Namespace, &req.ListOptionsModel, req.Fresh,
+		)
+	}
+	if err != nil {
+		return models.ListContainersReponseModel{}, err
+	}
+	err = sortObjects(pods, &req.ListOptionsModel, podStatus, podRestarts)
+	if err != nil {
+		return models.ListContainersReponseModel{}, err
+	}
 
 	resp := models.ListContainersReponseModel{}
 	resp.Containers = []models.ListContainersReponseModelContainer{}
-	for _, poddata := range pods.Items {
-
-		// skip container if it doesn't belong to the desired Pod
-		if req.PodName != "" && req.PodName != poddata.ObjectMeta.Name {
-			continue
-		}
+	for _, poddata := range pods {
 
 		for _, containerdata := range poddata.Spec.Containers {
 
@@ -145,12 +198,13 @@ func ListContainers(
 			resp.Containers = append(resp.Containers, currentContainer)
 		}
 	}
+	resp.Pagination = pagination(listMeta, len(pods))
 
-	return resp, err
+	return resp, nil
 
 }
 
-func ListNamespaces(clientset *kubernetes.Clientset) ([]string, error) {
+func ListNamespaces(clientset kubernetes.Interface) ([]string, error) {
 	// get a list of all namespaces using the Kubernetes API
 	namespaceList, err := clientset.CoreV1().Namespaces().List(
 		context.TODO(), metaapiv1.ListOptions{},
@@ -169,41 +223,54 @@ func ListNamespaces(clientset *kubernetes.Clientset) ([]string, error) {
 	return namespaces, nil
 }
 
+// summarized deployment as returned by list and watch endpoints
+func deploymentSummary(
+	deploymentdata *appsapiv1.Deployment,
+) models.ListDeploymentsResponseModelDeployment {
+
+	currentDeployment := models.ListDeploymentsResponseModelDeployment{}
+	currentDeployment.Namespace = deploymentdata.Namespace
+	currentDeployment.Name = deploymentdata.Name
+	currentDeployment.Replicas = deploymentdata.Status.Replicas
+	currentDeployment.ReadyReplicas = deploymentdata.Status.ReadyReplicas
+	currentDeployment.UpdatedReplicas = deploymentdata.Status.UpdatedReplicas
+	currentDeployment.UnavailableReplicas = deploymentdata.Status.UnavailableReplicas
+	currentDeployment.CreationTime =
+		deploymentdata.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+
+	return currentDeployment
+}
+
 func ListDeployments(
-	clientset *kubernetes.Clientset,
+	clientset kubernetes.Interface,
+	rc *ResourceCache,
 	req *models.ListDeploymentsRequestModel,
 ) (models.ListDeploymentsResponseModel, error) {
 
-	deploymentsClient := clientset.AppsV1().Deployments(req.Namespace)
-
-	deployments, err := deploymentsClient.List(
-		context.TODO(), metaapiv1.ListOptions{},
+	deployments, listMeta, err := listDeployments(
+		clientset, rc, req.Namespace, &req.ListOptionsModel, req.Fresh,
 	)
 	if err != nil {
-		panic(err.Error())
+		return models.ListDeploymentsResponseModel{}, err
+	}
+	err = sortObjects(deployments, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListDeploymentsResponseModel{}, err
 	}
 
 	resp := models.ListDeploymentsResponseModel{}
 	resp.Deployments = []models.ListDeploymentsResponseModelDeployment{}
-	for _, deploymentdata := range deployments.Items {
-		currentDeployment := models.ListDeploymentsResponseModelDeployment{}
-		currentDeployment.Namespace = deploymentdata.Namespace
-		currentDeployment.Name = deploymentdata.Name
-		currentDeployment.Replicas = deploymentdata.Status.Replicas
-		currentDeployment.ReadyReplicas = deploymentdata.Status.ReadyReplicas
-		currentDeployment.UpdatedReplicas = deploymentdata.Status.UpdatedReplicas
-		currentDeployment.UnavailableReplicas = deploymentdata.Status.UnavailableReplicas
-		currentDeployment.CreationTime =
-			deploymentdata.CreationTimestamp.Time.UTC().Format(time.RFC3339)
-		resp.Deployments = append(resp.Deployments, currentDeployment)
+	for i := range deployments {
+		resp.Deployments = append(resp.Deployments, deploymentSummary(&deployments[i]))
 	}
+	resp.Pagination = pagination(listMeta, len(resp.Deployments))
 
-	return resp, err
+	return resp, nil
 
 }
 
-func CreateDeployment(
-	clientset *kubernetes.Clientset, namespace string,
+// build deployment object from the request, resources are validated
+func buildDeployment(
 	req *models.CreateDeploymentRequestModel,
 ) (*appsapiv1.Deployment, error) {
 
@@ -289,6 +356,19 @@ func CreateDeployment(
 		},
 	}
 
+	return deployment, nil
+}
+
+func CreateDeployment(
+	clientset kubernetes.Interface, namespace string,
+	req *models.CreateDeploymentRequestModel,
+) (*appsapiv1.Deployment, error) {
+
+	deployment, err := buildDeployment(req)
+	if err != nil {
+		return nil, err
+	}
+
 	// create the deployment in k8s using the client-go library
 	dplmnt, err := clientset.AppsV1().Deployments(namespace).Create(
 		context.TODO(), deployment, metaapiv1.CreateOptions{},
@@ -301,18 +381,36 @@ func CreateDeployment(
 
 }
 
-func UpdateDeployment(
-	clientset *kubernetes.Clientset,
-	req *models.UpdateDeploymentRequestModel,
-) error {
+// preview CreateDeployment with server-side dry run
+func DiffCreateDeployment(
+	clientset kubernetes.Interface, namespace string,
+	req *models.CreateDeploymentRequestModel,
+) (models.DiffModel, error) {
 
-	deployment, err := clientset.AppsV1().Deployments(req.Namespace).Get(
-		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	deployment, err := buildDeployment(req)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	result, err := clientset.AppsV1().Deployments(namespace).Create(
+		context.TODO(), deployment, metaapiv1.CreateOptions{
+			DryRun: []string{metaapiv1.DryRunAll},
+		},
 	)
 	if err != nil {
-		return err
+		return models.DiffModel{}, err
 	}
 
+	return diffTypedObjects("Deployment", nil, result)
+
+}
+
+// change the deployment in place according to the request
+func applyDeploymentUpdate(
+	deployment *appsapiv1.Deployment,
+	req *models.UpdateDeploymentRequestModel,
+) error {
+
 	// Update the resource requirements of each container in the deployment
 	for i := range deployment.Spec.Template.Spec.Containers {
 
@@ -365,20 +463,84 @@ func UpdateDeployment(
 		*deployment.Spec.Replicas = req.Replicas
 	}
 
+	return nil
+}
+
+// update the deployment, warnings are returned when the replicas are set
+// but an autoscaler manages them
+func UpdateDeployment(
+	clientset kubernetes.Interface,
+	req *models.UpdateDeploymentRequestModel,
+) ([]string, error) {
+
+	deployment, err := clientset.AppsV1().Deployments(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyDeploymentUpdate(deployment, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the deployment in k8s
 	_, err = clientset.AppsV1().Deployments(req.Namespace).Update(
 		context.TODO(), deployment, metaapiv1.UpdateOptions{},
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	if req.Replicas == 0 {
+		return nil, nil
+	}
+
+	return manualScalingWarnings(clientset, req.Namespace, "Deployment", req.Name), nil
+
+}
+
+// preview UpdateDeployment with server-side dry run
+func DiffUpdateDeployment(
+	clientset kubernetes.Interface,
+	req *models.UpdateDeploymentRequestModel,
+) (models.DiffModel, error) {
+
+	live, err := clientset.AppsV1().Deployments(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	deployment := live.DeepCopy()
+	err = applyDeploymentUpdate(deployment, req)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	result, err := clientset.AppsV1().Deployments(req.Namespace).Update(
+		context.TODO(), deployment, metaapiv1.UpdateOptions{
+			DryRun: []string{metaapiv1.DryRunAll},
+		},
+	)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	diff, err := diffTypedObjects("Deployment", live, result)
+	if err != nil || req.Replicas == 0 {
+		return diff, err
+	}
+	diff.Warnings = manualScalingWarnings(clientset, req.Namespace, "Deployment", req.Name)
+
+	return diff, nil
 
 }
 
 func DeleteDeployment(
-	clientset *kubernetes.Clientset,
+	clientset kubernetes.Interface,
 	req *models.DeleteDeploymentRequestModel,
 ) error {
 
@@ -395,8 +557,33 @@ func DeleteDeployment(
 
 }
 
+// preview DeleteDeployment with server-side dry run
+func DiffDeleteDeployment(
+	clientset kubernetes.Interface,
+	req *models.DeleteDeploymentRequestModel,
+) (models.DiffModel, error) {
+
+	deploymentsClient := clientset.AppsV1().Deployments(req.Namespace)
+	live, err := deploymentsClient.Get(context.TODO(), req.Name, metaapiv1.GetOptions{})
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	deletePolicy := metaapiv1.DeletePropagationForeground
+	err = deploymentsClient.Delete(context.TODO(), req.Name, metaapiv1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+		DryRun:            []string{metaapiv1.DryRunAll},
+	})
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	return diffTypedObjects("Deployment", live, nil)
+
+}
+
 func GetPodMetricsV1(
-	metricsset *metricsv.Clientset, namespace string,
+	metricsset metricsv.Interface, namespace string,
 ) (*v1beta1.PodMetricsList, error) {
 
 	metrics, err := metricsset.MetricsV1beta1().PodMetricses(namespace).List(
@@ -410,21 +597,85 @@ func GetPodMetricsV1(
 
 }
 
-// this saves the pod metrics to a db
-func savePodMetricsToDB(metricsset *metricsv.Clientset, db *gorm.DB) error {
+// replica sets by namespace/name
+func replicaSetsByName(replicaSets []appsapiv1.ReplicaSet) map[string]*appsapiv1.ReplicaSet {
+
+	byName := map[string]*appsapiv1.ReplicaSet{}
+	for i := range replicaSets {
+		byName[replicaSets[i].Namespace+"/"+replicaSets[i].Name] = &replicaSets[i]
+	}
+
+	return byName
+}
+
+// workload owning the pod, pods of replica sets owned by another
+// controller (ex. deployment) belong to that controller
+func podWorkload(pod *coreapiv1.Pod, replicaSets map[string]*appsapiv1.ReplicaSet) (string, string) {
+
+	owner := metaapiv1.GetControllerOfNoCopy(pod)
+	if owner == nil {
+		return "", ""
+	}
+
+	if owner.Kind == "ReplicaSet" {
+		replicaSet := replicaSets[pod.Namespace+"/"+owner.Name]
+		if replicaSet != nil && (owner.UID == "" || replicaSet.UID == owner.UID) {
+			if replicaSetOwner := metaapiv1.GetControllerOfNoCopy(replicaSet); replicaSetOwner != nil {
+				return replicaSetOwner.Kind, replicaSetOwner.Name
+			}
+		}
+	}
+
+	return owner.Kind, owner.Name
+}
+
+// pod metrics record identifying the pod, nil pod leaves out
+// everything the metrics don't know
+func podMetricsRecord(
+	namespace string, name string, pod *coreapiv1.Pod, replicaSets map[string]*appsapiv1.ReplicaSet,
+) models.DBPodMetricsModel {
+
+	record := models.DBPodMetricsModel{Namespace: namespace, Name: name}
+	if pod != nil {
+		record.UID = string(pod.UID)
+		record.Node = pod.Spec.NodeName
+		record.WorkloadKind, record.WorkloadName = podWorkload(pod, replicaSets)
+	}
+
+	return record
+}
+
+// this saves the pod metrics of the cluster to a db, pods are read
+// from the resource cache once it's synced
+func savePodMetricsToDB(cluster *Cluster, db *gorm.DB) error {
 
 	// get raw metrics for all namespaces
-	metrics, err := GetPodMetricsV1(metricsset, "")
+	metrics, err := GetPodMetricsV1(cluster.Metricsset, "")
+	if err != nil {
+		return err
+	}
+	pods, _, err := listPods(cluster.Clientset, cluster.Cache, "", nil, false)
+	if err != nil {
+		return err
+	}
+	podsByName := map[string]*coreapiv1.Pod{}
+	for i := range pods {
+		podsByName[pods[i].Namespace+"/"+pods[i].Name] = &pods[i]
+	}
+	replicaSets, _, err := listReplicaSets(cluster.Clientset, cluster.Cache, "", nil, false)
 	if err != nil {
 		return err
 	}
-	clusterMetricsRecord := models.DBClusterMetricsModel{}
+	replicaSetsByName := replicaSetsByName(replicaSets)
+
+	clusterMetricsRecord := models.DBClusterMetricsModel{Cluster: cluster.Name}
 
 	for _, podMetrics := range metrics.Items {
 
-		podMetricsRecord := models.DBPodMetricsModel{
-			Name: podMetrics.Name,
-		}
+		podMetricsRecord := podMetricsRecord(
+			podMetrics.Namespace, podMetrics.Name,
+			podsByName[podMetrics.Namespace+"/"+podMetrics.Name], replicaSetsByName,
+		)
 
 		for _, cont := range podMetrics.Containers {
 			containerMetricsRecord := models.DBContainerMetricsModel{
@@ -443,25 +694,171 @@ func savePodMetricsToDB(metricsset *metricsv.Clientset, db *gorm.DB) error {
 		)
 	}
 
-	db.Create(&clusterMetricsRecord)
+	return db.Create(&clusterMetricsRecord).Error
+}
 
-	return nil
+// name of the migration filling the pod identity of stored metrics
+const podMetricsIdentityMigration = "pod_metrics_identity"
+
+// fill the pod identity of metrics stored before it was recorded, records are
+// matched by name to the current pods of the cluster when only one pod has
+// the name and the record is newer than the pod, others keep empty namespace,
+// the UID is left empty as the records may come from an earlier pod, the
+// migration is done once for every cluster when all records are assigned
+// to a cluster
+func MigratePodMetrics(cluster *Cluster, db *gorm.DB) error {
+
+	identity := func(record models.DBPodMetricsModel) map[string]interface{} {
+		return map[string]interface{}{
+			"namespace":     record.Namespace,
+			"uid":           record.UID,
+			"node":          record.Node,
+			"workload_kind": record.WorkloadKind,
+			"workload_name": record.WorkloadName,
+		}
+	}
+
+	// columns added to the existing table are null
+	err := db.Model(&models.DBPodMetricsModel{}).
+		Where("namespace IS NULL").
+		Updates(identity(models.DBPodMetricsModel{})).
+		Error
+	if err != nil {
+		return err
+	}
+
+	migration := models.DBMigrationModel{Name: podMetricsIdentityMigration, Cluster: cluster.Name}
+	var done int64
+	err = db.Model(&models.DBMigrationModel{}).
+		Where("name = ? AND cluster = ?", migration.Name, migration.Cluster).
+		Count(&done).
+		Error
+	if err != nil || done > 0 {
+		return err
+	}
+
+	// records stored before the server knew about clusters would be
+	// missed, they have to be assigned to the default cluster first
+	var unassigned int64
+	err = db.Model(&models.DBClusterMetricsModel{}).
+		Where("cluster IS NULL OR cluster = ''").
+		Count(&unassigned).
+		Error
+	if err != nil {
+		return err
+	}
+	if unassigned > 0 {
+		return fmt.Errorf("%d metrics records aren't assigned to a cluster", unassigned)
+	}
+
+	records := db.Model(&models.DBClusterMetricsModel{}).
+		Select("id").
+		Where("cluster = ?", cluster.Name)
+	var unidentified int64
+	err = db.Model(&models.DBPodMetricsModel{}).
+		Where("namespace = '' AND c_rec_id IN (?)", records).
+		Count(&unidentified).
+		Error
+	if err != nil {
+		return err
+	}
+	if unidentified == 0 {
+		return db.Create(&migration).Error
+	}
+
+	pods, err := cluster.Clientset.CoreV1().Pods("").List(context.TODO(), metaapiv1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	podsByName := map[string][]*coreapiv1.Pod{}
+	for i := range pods.Items {
+		podsByName[pods.Items[i].Name] = append(podsByName[pods.Items[i].Name], &pods.Items[i])
+	}
+	replicaSets, err := cluster.Clientset.AppsV1().ReplicaSets("").List(context.TODO(), metaapiv1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	replicaSetsByName := replicaSetsByName(replicaSets.Items)
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for name, matching := range podsByName {
+			if len(matching) != 1 {
+				continue
+			}
+			pod := matching[0]
+			record := podMetricsRecord(pod.Namespace, name, pod, replicaSetsByName)
+			record.UID = ""
+			err := tx.Model(&models.DBPodMetricsModel{}).
+				Where(
+					"name = ? AND namespace = '' AND created_at >= ? AND c_rec_id IN (?)",
+					name, pod.CreationTimestamp.Time, records,
+				).
+				Updates(identity(record)).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// records which didn't match keep empty namespace for good
+		return tx.Create(&migration).Error
+	})
 }
 
-// start monitoring the pod	metrics periodically every 5 seconds
-// and save the data to SQL database
-func StartPodMetricsMonitor(
-	metricsset *metricsv.Clientset, db *gorm.DB,
+// this saves the node metrics of the cluster with the allocatable
+// resources of the nodes to a db
+func saveNodeMetricsToDB(
+	clientset kubernetes.Interface, metricsset metricsv.Interface, cluster string, db *gorm.DB,
 ) error {
 
+	metrics, err := metricsset.MetricsV1beta1().NodeMetricses().List(
+		context.TODO(), metaapiv1.ListOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	nodes, err := clientset.CoreV1().Nodes().List(context.TODO(), metaapiv1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	allocatable := map[string]coreapiv1.ResourceList{}
+	for _, node := range nodes.Items {
+		allocatable[node.Name] = node.Status.Allocatable
+	}
+
+	clusterMetricsRecord := models.DBClusterNodeMetricsModel{Cluster: cluster}
+	for _, nodeMetrics := range metrics.Items {
+		nodeAllocatable := allocatable[nodeMetrics.Name]
+		clusterMetricsRecord.Nodes = append(clusterMetricsRecord.Nodes, models.DBNodeMetricsModel{
+			Name:              nodeMetrics.Name,
+			Timestamp:         nodeMetrics.Timestamp.UTC(),
+			CPUUsage:          nodeMetrics.Usage.Cpu().MilliValue(),
+			MemoryUsage:       nodeMetrics.Usage.Memory().Value(),
+			CPUAllocatable:    nodeAllocatable.Cpu().MilliValue(),
+			MemoryAllocatable: nodeAllocatable.Memory().Value(),
+		})
+	}
+
+	return db.Create(&clusterMetricsRecord).Error
+}
+
+// start monitoring the pod and node metrics periodically every 5 seconds
+// and save the data to SQL database
+func StartMetricsMonitor(cluster *Cluster, db *gorm.DB) error {
+
 	// create ticker with ticks every 5 seconds
 	ticker := time.NewTicker(5 * time.Second)
 	go func() {
 		for range ticker.C {
-			err := savePodMetricsToDB(metricsset, db)
+			// failed collections are retried with the next tick, pod
+			// and node metrics are collected independently
+			err := savePodMetricsToDB(cluster, db)
+			if err != nil {
+				log.Printf("collecting pod metrics of cluster %s failed: %v", cluster.Name, err)
+			}
+			err = saveNodeMetricsToDB(cluster.Clientset, cluster.Metricsset, cluster.Name, db)
 			if err != nil {
-				// TODO: find a way to error handle the ticker
-				return
+				log.Printf("collecting node metrics of cluster %s failed: %v", cluster.Name, err)
 			}
 		}
 	}()
@@ -469,12 +866,20 @@ func StartPodMetricsMonitor(
 	return nil
 }
 
+// pod metrics records of the cluster, podname is a LIKE pattern selecting
+// the pods which can be narrowed down to a namespace or workload
 func GetPodMetricsV2(
-	metricsset *metricsv.Clientset, db *gorm.DB,
+	cluster *Cluster, db *gorm.DB,
 	podname string,
+	req *models.GetPodMetricsV2RequestModel,
 	starttime *time.Time, endtime *time.Time,
 ) ([]models.DBClusterMetricsModel, error) {
 
+	err := requireList(cluster, "metrics.k8s.io", "pods", req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	var clusterMetricsRecords []models.DBClusterMetricsModel
 	//fmt.Println(podname)
 
@@ -489,25 +894,43 @@ func GetPodMetricsV2(
 		// validate time range (max 4 hours for all and 72 hours for single pod)
 		// this is mainly to not return enourmous sized json responses
 		maxHours := 4 * time.Hour
-		if podname != "%" {
+		if podname != "%" || req.WorkloadName != "" {
 			maxHours = 72 * time.Hour
 		}
 		if endtime.Sub(*starttime) > maxHours {
-			return nil, errors.New("time range too wide")
+			return nil, fmt.Errorf("%w: time range too wide", ErrInvalidRequest)
 		}
 
 		// validate if starttime is not after than endtime
 		if starttime.After(*endtime) {
-			return nil, errors.New("start_time cannot be after end_time")
+			return nil, fmt.Errorf("%w: start_time cannot be after end_time", ErrInvalidRequest)
 		}
 
 		// all records within set timeframe
 		dbtx = db.Where("created_at BETWEEN ? AND ?", starttime, endtime)
 	}
 
+	conditions := []string{"name LIKE ?"}
+	args := []interface{}{podname}
+	for _, filter := range []struct {
+		column string
+		value  string
+	}{
+		{"namespace", req.Namespace},
+		{"workload_kind", req.WorkloadKind},
+		{"workload_name", req.WorkloadName},
+	} {
+		if filter.value != "" {
+			conditions = append(conditions, filter.column+" = ?")
+			args = append(args, filter.value)
+		}
+	}
+	podConditions := append([]interface{}{strings.Join(conditions, " AND ")}, args...)
+
 	// podname doesn't have validation so it will return list of metrics records
 	// but the list of pods will be empty
-	err := dbtx.Preload("Pods", "name LIKE ?", podname).
+	err = dbtx.Where("cluster = ?", cluster.Name).
+		Preload("Pods", podConditions...).
 		Preload("Pods.Containers").
 		Find(&clusterMetricsRecords).
 		Error
@@ -518,12 +941,57 @@ func GetPodMetricsV2(
 	return clusterMetricsRecords, nil
 }
 
-func CreateService(
-	clientset *kubernetes.Clientset,
-	req *models.CreateServiceRequestModel,
-) error {
+// node metrics records of the cluster with the same time range rules as
+// GetPodMetricsV2, nodename is a LIKE pattern selecting the nodes
+func GetNodeMetrics(
+	cluster *Cluster, db *gorm.DB,
+	nodename string,
+	starttime *time.Time, endtime *time.Time,
+) ([]models.DBClusterNodeMetricsModel, error) {
 
-	service := &coreapiv1.Service{
+	err := requireList(cluster, "metrics.k8s.io", "nodes", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterMetricsRecords []models.DBClusterNodeMetricsModel
+
+	var dbtx *gorm.DB
+	if starttime == nil && endtime == nil {
+
+		// only most recent record
+		dbtx = db.Order("created_at DESC").Limit(1)
+
+	} else {
+
+		maxHours := 4 * time.Hour
+		if nodename != "%" {
+			maxHours = 72 * time.Hour
+		}
+		if endtime.Sub(*starttime) > maxHours {
+			return nil, fmt.Errorf("%w: time range too wide", ErrInvalidRequest)
+		}
+		if starttime.After(*endtime) {
+			return nil, fmt.Errorf("%w: start_time cannot be after end_time", ErrInvalidRequest)
+		}
+
+		dbtx = db.Where("created_at BETWEEN ? AND ?", starttime, endtime)
+	}
+
+	err = dbtx.Where("cluster = ?", cluster.Name).
+		Preload("Nodes", "name LIKE ?", nodename).
+		Find(&clusterMetricsRecords).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterMetricsRecords, nil
+}
+
+func buildService(req *models.CreateServiceRequestModel) *coreapiv1.Service {
+
+	return &coreapiv1.Service{
 		ObjectMeta: metaapiv1.ObjectMeta{
 			Name: req.Name,
 		},
@@ -541,6 +1009,14 @@ func CreateService(
 			Selector: req.Selector,
 		},
 	}
+}
+
+func CreateService(
+	clientset kubernetes.Interface,
+	req *models.CreateServiceRequestModel,
+) error {
+
+	service := buildService(req)
 
 	created_service, err := clientset.CoreV1().Services(req.Namespace).Create(
 		context.TODO(), service, metaapiv1.CreateOptions{},
@@ -555,49 +1031,82 @@ func CreateService(
 	return nil
 }
 
+// preview CreateService with server-side dry run
+func DiffCreateService(
+	clientset kubernetes.Interface,
+	req *models.CreateServiceRequestModel,
+) (models.DiffModel, error) {
+
+	result, err := clientset.CoreV1().Services(req.Namespace).Create(
+		context.TODO(), buildService(req), metaapiv1.CreateOptions{
+			DryRun: []string{metaapiv1.DryRunAll},
+		},
+	)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	return diffTypedObjects("Service", nil, result)
+}
+
+// summarized service as returned by list and watch endpoints
+func serviceSummary(servicedata *coreapiv1.Service) models.ListServicesResponseModelService {
+
+	currentService := models.ListServicesResponseModelService{}
+	currentService.Name = servicedata.ObjectMeta.Name
+	currentService.Namespace = servicedata.ObjectMeta.Namespace
+	currentService.Type = string(servicedata.Spec.Type)
+	currentService.Selector = servicedata.Spec.Selector
+	currentService.ClusterIPs = servicedata.Spec.ClusterIPs
+	//servicedata.Spec.ExternalIPs
+
+	currentService.Ports = []models.ListServicesResponseModelPort{}
+	for _, portdata := range servicedata.Spec.Ports {
+		currentService.Ports = append(
+			currentService.Ports,
+			models.ListServicesResponseModelPort{
+				Name:       portdata.Name,
+				Protocol:   string(portdata.Protocol),
+				Port:       portdata.Port,
+				NodePort:   portdata.NodePort,
+				TargetPort: portdata.TargetPort.IntVal,
+			},
+		)
+	}
+
+	return currentService
+}
+
 func ListServices(
-	clientset *kubernetes.Clientset,
+	clientset kubernetes.Interface,
+	rc *ResourceCache,
 	req *models.ListServicesRequestModel,
 ) (models.ListServicesResponseModel, error) {
 
-	services, err := clientset.CoreV1().Services(req.Namespace).List(
-		context.TODO(), metaapiv1.ListOptions{},
+	services, listMeta, err := listServices(
+		clientset, rc, req.Namespace, &req.ListOptionsModel, req.Fresh,
 	)
+	if err != nil {
+		return models.ListServicesResponseModel{}, err
+	}
+	err = sortObjects(services, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListServicesResponseModel{}, err
+	}
 
 	resp := models.ListServicesResponseModel{}
 	resp.Services = []models.ListServicesResponseModelService{}
-	for _, servicedata := range services.Items {
-		currentService := models.ListServicesResponseModelService{}
-		currentService.Name = servicedata.ObjectMeta.Name
-		currentService.Namespace = servicedata.ObjectMeta.Namespace
-		currentService.Type = string(servicedata.Spec.Type)
-		currentService.Selector = servicedata.Spec.Selector
-		currentService.ClusterIPs = servicedata.Spec.ClusterIPs
-		//servicedata.Spec.ExternalIPs
-
-		currentService.Ports = []models.ListServicesResponseModelPort{}
-		for _, portdata := range servicedata.Spec.Ports {
-			currentService.Ports = append(
-				currentService.Ports,
-				models.ListServicesResponseModelPort{
-					Name:       portdata.Name,
-					Protocol:   string(portdata.Protocol),
-					Port:       portdata.Port,
-					NodePort:   portdata.NodePort,
-					TargetPort: portdata.TargetPort.IntVal,
-				},
-			)
-		}
-
-		resp.Services = append(resp.Services, currentService)
+	for i := range services {
+		resp.Services = append(resp.Services, serviceSummary(&services[i]))
 	}
+	resp.Pagination = pagination(listMeta, len(resp.Services))
 
-	return resp, err
+	return resp, nil
 
 }
 
 func DeleteService(
-	clientset *kubernetes.Clientset,
+	clientset kubernetes.Interface,
 	req *models.DeleteServiceRequestModel,
 ) error {
 
@@ -607,3 +1116,29 @@ func DeleteService(
 	return err
 
 }
+
+// preview DeleteService with server-side dry run
+func DiffDeleteService(
+	clientset kubernetes.Interface,
+	req *models.DeleteServiceRequestModel,
+) (models.DiffModel, error) {
+
+	live, err := clientset.CoreV1().Services(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	err = clientset.CoreV1().Services(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{
+			DryRun: []string{metaapiv1.DryRunAll},
+		},
+	)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	return diffTypedObjects("Service", live, nil)
+
+}
@@ -0,0 +1,289 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	DiffChangeAdded   = "added"
+	DiffChangeRemoved = "removed"
+	DiffChangeChanged = "changed"
+)
+
+// lines of unchanged text shown around every change in unified diff
+const diffContextLines = 3
+
+// convert typed object (ex. *appsapiv1.Deployment) to unstructured
+func toUnstructured(object runtime.Object) (*unstructured.Unstructured, error) {
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// walk both values and record every leaf which differs,
+// lists are compared index by index
+func diffValues(path string, old interface{}, new interface{}, changes *[]models.DiffChangeModel) {
+
+	oldMap, oldIsMap := old.(map[string]interface{})
+	newMap, newIsMap := new.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := map[string]bool{}
+		for key := range oldMap {
+			keys[key] = true
+		}
+		for key := range newMap {
+			keys[key] = true
+		}
+		sortedKeys := []string{}
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+
+		for _, key := range sortedKeys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			oldChild, inOld := oldMap[key]
+			newChild, inNew := newMap[key]
+			switch {
+			case !inOld:
+				*changes = append(*changes, models.DiffChangeModel{
+					Path: childPath, Type: DiffChangeAdded, New: newChild,
+				})
+			case !inNew:
+				*changes = append(*changes, models.DiffChangeModel{
+					Path: childPath, Type: DiffChangeRemoved, Old: oldChild,
+				})
+			default:
+				diffValues(childPath, oldChild, newChild, changes)
+			}
+		}
+		return
+	}
+
+	oldList, oldIsList := old.([]interface{})
+	newList, newIsList := new.([]interface{})
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(oldList):
+				*changes = append(*changes, models.DiffChangeModel{
+					Path: childPath, Type: DiffChangeAdded, New: newList[i],
+				})
+			case i >= len(newList):
+				*changes = append(*changes, models.DiffChangeModel{
+					Path: childPath, Type: DiffChangeRemoved, Old: oldList[i],
+				})
+			default:
+				diffValues(childPath, oldList[i], newList[i], changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*changes = append(*changes, models.DiffChangeModel{
+			Path: path, Type: DiffChangeChanged, Old: old, New: new,
+		})
+	}
+}
+
+func toYAMLLines(object *unstructured.Unstructured) ([]string, error) {
+
+	if object == nil {
+		return []string{}, nil
+	}
+
+	data, err := yaml.Marshal(object.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.SplitAfter(string(data), "\n"), nil
+}
+
+// line diff in unified format based on longest common subsequence,
+// objects are small enough for the quadratic algorithm
+func unifiedDiff(oldLines []string, newLines []string, oldName string, newName string) string {
+
+	// drop the empty string after the last newline
+	if len(oldLines) > 0 && oldLines[len(oldLines)-1] == "" {
+		oldLines = oldLines[:len(oldLines)-1]
+	}
+	if len(newLines) > 0 && newLines[len(newLines)-1] == "" {
+		newLines = newLines[:len(newLines)-1]
+	}
+
+	n, m := len(oldLines), len(newLines)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	// edit script, op is ' ', '-' or '+'
+	type edit struct {
+		op   byte
+		line string
+		// position in old and new text before this edit
+		oldPos int
+		newPos int
+	}
+	edits := []edit{}
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && oldLines[i] == newLines[j]:
+			edits = append(edits, edit{' ', oldLines[i], i, j})
+			i++
+			j++
+		case j < m && (i == n || lcs[i][j+1] >= lcs[i+1][j]):
+			edits = append(edits, edit{'+', newLines[j], i, j})
+			j++
+		default:
+			edits = append(edits, edit{'-', oldLines[i], i, j})
+			i++
+		}
+	}
+
+	// group changes with their context into hunks
+	var out strings.Builder
+	for start := 0; start < len(edits); {
+		if edits[start].op == ' ' {
+			start++
+			continue
+		}
+
+		hunkStart := max(start-diffContextLines, 0)
+		hunkEnd := start
+		for k := start; k < len(edits); k++ {
+			if edits[k].op != ' ' {
+				hunkEnd = k + 1
+			} else if k-hunkEnd >= 2*diffContextLines {
+				break
+			}
+		}
+		hunkEnd = min(hunkEnd+diffContextLines, len(edits))
+
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		oldCount, newCount := 0, 0
+		for _, e := range edits[hunkStart:hunkEnd] {
+			if e.op != '+' {
+				oldCount++
+			}
+			if e.op != '-' {
+				newCount++
+			}
+		}
+		oldStart, newStart := edits[hunkStart].oldPos, edits[hunkStart].newPos
+		if oldCount > 0 {
+			oldStart++
+		}
+		if newCount > 0 {
+			newStart++
+		}
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+		for _, e := range edits[hunkStart:hunkEnd] {
+			out.WriteByte(e.op)
+			out.WriteString(strings.TrimSuffix(e.line, "\n"))
+			out.WriteByte('\n')
+		}
+
+		start = hunkEnd
+	}
+
+	return out.String()
+}
+
+// diff of the object before (nil if it didn't exist) and after the mutation
+// (nil if it was deleted), managedFields, status and fields set by the server
+// on every write are ignored
+func diffObjects(
+	kind string, live *unstructured.Unstructured, result *unstructured.Unstructured,
+) (models.DiffModel, error) {
+
+	diff := models.DiffModel{Kind: kind}
+	diff.Changes = []models.DiffChangeModel{}
+
+	var oldContent, newContent interface{} = map[string]interface{}{}, map[string]interface{}{}
+	if live != nil {
+		live = normalizeObject(live)
+		unstructured.RemoveNestedField(live.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(live.Object, "metadata", "uid")
+		diff.Name, diff.Namespace = live.GetName(), live.GetNamespace()
+		oldContent = live.Object
+	}
+	if result != nil {
+		result = normalizeObject(result)
+		unstructured.RemoveNestedField(result.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(result.Object, "metadata", "uid")
+		diff.Name, diff.Namespace = result.GetName(), result.GetNamespace()
+		newContent = result.Object
+	}
+
+	diffValues("", oldContent, newContent, &diff.Changes)
+
+	oldLines, err := toYAMLLines(live)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+	newLines, err := toYAMLLines(result)
+	if err != nil {
+		return models.DiffModel{}, err
+	}
+
+	objectPath := strings.ToLower(kind) + "/" + diff.Name
+	if diff.Namespace != "" {
+		objectPath = diff.Namespace + "/" + objectPath
+	}
+	diff.Unified = unifiedDiff(oldLines, newLines, "live/"+objectPath, "result/"+objectPath)
+
+	return diff, nil
+}
+
+// diffObjects for typed objects, nil pointers mean the object doesn't exist
+func diffTypedObjects[T runtime.Object](kind string, live T, result T) (models.DiffModel, error) {
+
+	var liveContent, resultContent *unstructured.Unstructured
+	var err error
+	if !reflect.ValueOf(live).IsNil() {
+		liveContent, err = toUnstructured(live)
+		if err != nil {
+			return models.DiffModel{}, err
+		}
+	}
+	if !reflect.ValueOf(result).IsNil() {
+		resultContent, err = toUnstructured(result)
+		if err != nil {
+			return models.DiffModel{}, err
+		}
+	}
+
+	return diffObjects(kind, liveContent, resultContent)
+}
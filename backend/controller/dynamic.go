@@ -0,0 +1,364 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// ask API server to render the list the same way kubectl get does
+const tableAcceptHeader = "application/json;as=Table;v=v1;g=meta.k8s.io,application/json"
+
+// client able to work with any resource kind including CRDs,
+// resources are resolved with discovery which is cached in memory
+type DynamicClient struct {
+	Client    dynamic.Interface
+	Discovery discovery.CachedDiscoveryInterface
+	Mapper    *restmapper.DeferredDiscoveryRESTMapper
+	// requests the dynamic client can't make (ex. tables), made with the
+	// same identity as Client, discovery may be shared between users
+	REST rest.Interface
+}
+
+// client for requests by absolute path like the one of discovery
+func newRESTClient(config *rest.Config) (rest.Interface, error) {
+
+	config = rest.CopyConfig(config)
+	config.APIPath = ""
+	config.GroupVersion = nil
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	client, err := rest.UnversionedRESTClientFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating REST client: %v", err)
+	}
+
+	return client, nil
+}
+
+func NewDynamicClient(config *rest.Config) (*DynamicClient, error) {
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %v", err)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery client: %v", err)
+	}
+	cachedDiscovery := memory.NewMemCacheClient(discoveryClient)
+
+	restClient, err := newRESTClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamicClient{
+		Client:    client,
+		Discovery: cachedDiscovery,
+		Mapper:    restmapper.NewDeferredDiscoveryRESTMapper(cachedDiscovery),
+		REST:      restClient,
+	}, nil
+}
+
+// find the full resource mapping for possibly partial group/version/resource,
+// mapper refreshes discovery by itself when the resource is unknown (new CRD)
+func (dc *DynamicClient) resolveResource(
+	group string, version string, resource string,
+) (*meta.RESTMapping, error) {
+
+	gvr, err := dc.Mapper.ResourceFor(schema.GroupVersionResource{
+		Group: group, Version: version, Resource: strings.ToLower(resource),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	gvk, err := dc.Mapper.KindFor(gvr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	return dc.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+}
+
+// client for the resource, namespace is ignored for cluster scoped resources
+// and empty namespace means all namespaces
+func (dc *DynamicClient) resourceInterface(
+	mapping *meta.RESTMapping, namespace string,
+) dynamic.ResourceInterface {
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return dc.Client.Resource(mapping.Resource).Namespace(namespace)
+	}
+
+	return dc.Client.Resource(mapping.Resource)
+}
+
+// single namespaced object can't be addressed without its namespace
+func checkNamespaceGiven(mapping *meta.RESTMapping, namespace string) error {
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace && namespace == "" {
+		return fmt.Errorf(
+			"%w: namespace is required for %s", ErrInvalidRequest, mapping.Resource.Resource,
+		)
+	}
+
+	return nil
+}
+
+// objects of every listable resource in the namespace (of cluster scoped
+// resources when namespace is empty) accepted by the include filter,
+// resources which can't be listed (ex. forbidden) are skipped
+func (dc *DynamicClient) listObjects(
+	namespace string,
+	include func(gvr schema.GroupVersionResource, verbs []string) bool,
+) ([]*unstructured.Unstructured, error) {
+
+	resourceLists, err := dc.Discovery.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, apiResource := range resourceList.APIResources {
+			if strings.Contains(apiResource.Name, "/") ||
+				apiResource.Namespaced != (namespace != "") ||
+				!slices.Contains(apiResource.Verbs, "list") {
+				continue
+			}
+			gvr := gv.WithResource(apiResource.Name)
+			if !include(gvr, apiResource.Verbs) {
+				continue
+			}
+
+			list, err := dc.Client.Resource(gvr).Namespace(namespace).List(
+				context.TODO(), metaapiv1.ListOptions{},
+			)
+			if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) ||
+				apierrors.IsMethodNotSupported(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range list.Items {
+				// list items come without kind
+				list.Items[i].SetGroupVersionKind(gv.WithKind(apiResource.Kind))
+				objects = append(objects, &list.Items[i])
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+func ListAPIResources(dc *DynamicClient) (models.ListAPIResourcesResponseModel, error) {
+
+	// aggregated APIs which are down (ex. metrics-server) make discovery
+	// partially fail, the rest of the resources is still usable
+	resourceLists, err := dc.Discovery.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return models.ListAPIResourcesResponseModel{}, err
+	}
+
+	resp := models.ListAPIResourcesResponseModel{}
+	resp.Resources = []models.APIResourceModel{}
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, apiResource := range resourceList.APIResources {
+			// skip subresources like pods/log
+			if strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+			resp.Resources = append(resp.Resources, models.APIResourceModel{
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Resource:   apiResource.Name,
+				Kind:       apiResource.Kind,
+				Namespaced: apiResource.Namespaced,
+				Verbs:      apiResource.Verbs,
+			})
+		}
+	}
+
+	sort.Slice(resp.Resources, func(i, j int) bool {
+		if resp.Resources[i].Group != resp.Resources[j].Group {
+			return resp.Resources[i].Group < resp.Resources[j].Group
+		}
+		return resp.Resources[i].Resource < resp.Resources[j].Resource
+	})
+
+	return resp, nil
+
+}
+
+func ListResources(
+	dc *DynamicClient,
+	req *models.ListResourcesRequestModel,
+) (models.ListResourcesResponseModel, error) {
+
+	mapping, err := dc.resolveResource(req.Group, req.Version, req.Resource)
+	if err != nil {
+		return models.ListResourcesResponseModel{}, err
+	}
+
+	// dynamic client can't ask for tables so the request is built by hand
+	gvr := mapping.Resource
+	path := []string{"/apis", gvr.Group, gvr.Version}
+	if gvr.Group == "" {
+		path = []string{"/api", gvr.Version}
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace && req.Namespace != "" {
+		path = append(path, "namespaces", req.Namespace)
+	}
+	path = append(path, gvr.Resource)
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListResourcesResponseModel{}, err
+	}
+	request := dc.REST.Get().
+		AbsPath(path...).
+		SetHeader("Accept", tableAcceptHeader).
+		Param("includeObject", string(metaapiv1.IncludeObject))
+	if options.LabelSelector != "" {
+		request = request.Param("labelSelector", options.LabelSelector)
+	}
+	if options.FieldSelector != "" {
+		request = request.Param("fieldSelector", options.FieldSelector)
+	}
+	if options.Limit != 0 {
+		request = request.Param("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Continue != "" {
+		request = request.Param("continue", options.Continue)
+	}
+
+	raw, err := request.Do(context.TODO()).Raw()
+	if err != nil {
+		return models.ListResourcesResponseModel{}, listError(err)
+	}
+
+	table := metaapiv1.Table{}
+	err = json.Unmarshal(raw, &table)
+	if err != nil {
+		return models.ListResourcesResponseModel{}, err
+	}
+	if table.Kind != "Table" {
+		return models.ListResourcesResponseModel{},
+			fmt.Errorf("server didn't return a table for %s", gvr.String())
+	}
+
+	resp := models.ListResourcesResponseModel{}
+	resp.Columns = []models.ResourceTableColumnModel{}
+	for _, column := range table.ColumnDefinitions {
+		resp.Columns = append(resp.Columns, models.ResourceTableColumnModel{
+			Name:        column.Name,
+			Type:        column.Type,
+			Description: column.Description,
+			Priority:    column.Priority,
+		})
+	}
+
+	resp.Rows = []models.ResourceTableRowModel{}
+	for _, row := range table.Rows {
+		currentRow := models.ResourceTableRowModel{Cells: row.Cells}
+		if len(row.Object.Raw) > 0 {
+			err = json.Unmarshal(row.Object.Raw, &currentRow.Object)
+			if err != nil {
+				return models.ListResourcesResponseModel{}, err
+			}
+			if metadata, ok := currentRow.Object["metadata"].(map[string]interface{}); ok {
+				currentRow.Name, _ = metadata["name"].(string)
+				currentRow.Namespace, _ = metadata["namespace"].(string)
+			}
+		}
+		resp.Rows = append(resp.Rows, currentRow)
+	}
+	err = sortTableRows(resp.Rows, resp.Columns, &req.ListOptionsModel)
+	if err != nil {
+		return models.ListResourcesResponseModel{}, err
+	}
+	resp.Pagination = pagination(table.ListMeta, len(resp.Rows))
+
+	return resp, nil
+
+}
+
+func GetResource(
+	dc *DynamicClient,
+	req *models.GetResourceRequestModel,
+) (map[string]interface{}, error) {
+
+	mapping, err := dc.resolveResource(req.Group, req.Version, req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNamespaceGiven(mapping, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := dc.resourceInterface(mapping, req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Object, nil
+
+}
+
+func DeleteResource(
+	dc *DynamicClient,
+	req *models.DeleteResourceRequestModel,
+) error {
+
+	mapping, err := dc.resolveResource(req.Group, req.Version, req.Resource)
+	if err != nil {
+		return err
+	}
+	err = checkNamespaceGiven(mapping, req.Namespace)
+	if err != nil {
+		return err
+	}
+
+	deletePolicy := metaapiv1.DeletePropagationBackground
+	err = dc.resourceInterface(mapping, req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{
+			PropagationPolicy: &deletePolicy,
+		},
+	)
+	return err
+
+}
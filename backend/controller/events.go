@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	coreapiv1 "k8s.io/api/core/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// default number of events returned from the history
+const eventHistoryDefaultLimit = 500
+
+// events reported through events.k8s.io don't set the legacy timestamps
+func eventTimes(event *coreapiv1.Event) (first time.Time, last time.Time) {
+
+	first = event.FirstTimestamp.Time
+	last = event.LastTimestamp.Time
+	if first.IsZero() {
+		first = event.EventTime.Time
+	}
+	if first.IsZero() {
+		first = event.CreationTimestamp.Time
+	}
+	if last.IsZero() && event.Series != nil {
+		last = event.Series.LastObservedTime.Time
+	}
+	if last.IsZero() {
+		last = first
+	}
+
+	return first.UTC(), last.UTC()
+}
+
+func eventToModel(event *coreapiv1.Event) models.DBEventModel {
+
+	record := models.DBEventModel{
+		UID:          string(event.UID),
+		Namespace:    event.Namespace,
+		InvolvedKind: event.InvolvedObject.Kind,
+		InvolvedName: event.InvolvedObject.Name,
+		Type:         event.Type,
+		Reason:       event.Reason,
+		Message:      event.Message,
+		Source:       event.Source.Component,
+		Count:        event.Count,
+	}
+	if record.Source == "" {
+		record.Source = event.ReportingController
+	}
+	if record.Count == 0 {
+		record.Count = 1
+		if event.Series != nil {
+			record.Count = event.Series.Count
+		}
+	}
+	record.FirstTimestamp, record.LastTimestamp = eventTimes(event)
+
+	return record
+}
+
+func ListEvents(
+	clientset kubernetes.Interface,
+	req *models.ListEventsRequestModel,
+) (models.ListEventsResponseModel, error) {
+
+	// filtering is done by the API server
+	selector := fields.Set{}
+	if req.InvolvedKind != "" {
+		selector["involvedObject.kind"] = req.InvolvedKind
+	}
+	if req.InvolvedName != "" {
+		selector["involvedObject.name"] = req.InvolvedName
+	}
+	if req.Type != "" {
+		selector["type"] = req.Type
+	}
+	if req.Reason != "" {
+		selector["reason"] = req.Reason
+	}
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListEventsResponseModel{}, err
+	}
+	if len(selector) > 0 {
+		if options.FieldSelector != "" {
+			options.FieldSelector += ","
+		}
+		options.FieldSelector += selector.AsSelector().String()
+	}
+
+	events, err := clientset.CoreV1().Events(req.Namespace).List(context.TODO(), options)
+	if err != nil {
+		return models.ListEventsResponseModel{}, listError(err)
+	}
+	err = sortObjects(
+		events.Items, &req.ListOptionsModel,
+		func(event *coreapiv1.Event) string {
+			return event.Type
+		},
+		nil,
+	)
+	if err != nil {
+		return models.ListEventsResponseModel{}, err
+	}
+
+	resp := models.ListEventsResponseModel{}
+	resp.Events = []models.DBEventModel{}
+	for i := range events.Items {
+		resp.Events = append(resp.Events, eventToModel(&events.Items[i]))
+	}
+	resp.Pagination = pagination(events.ListMeta, len(resp.Events))
+
+	// most recent first unless other order was requested
+	if sortBy, _ := sortOrder(&req.ListOptionsModel); sortBy == "" {
+		sort.SliceStable(resp.Events, func(i, j int) bool {
+			return resp.Events[i].LastTimestamp.After(resp.Events[j].LastTimestamp)
+		})
+	}
+
+	return resp, nil
+
+}
+
+// this saves the events to a db, already stored events are updated
+// only when they occurred again
+func saveEventsToDB(clientset kubernetes.Interface, cluster string, db *gorm.DB) error {
+
+	events, err := clientset.CoreV1().Events("").List(
+		context.TODO(), metaapiv1.ListOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if len(events.Items) == 0 {
+		return nil
+	}
+
+	records := []models.DBEventModel{}
+	for i := range events.Items {
+		record := eventToModel(&events.Items[i])
+		record.Cluster = cluster
+		records = append(records, record)
+	}
+
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "uid"}},
+		// skip the write when the count didn't change
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "db_event_models.count <> excluded.count"},
+		}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"count", "message", "last_timestamp", "updated_at",
+		}),
+	}).CreateInBatches(&records, 100).Error
+}
+
+// start collecting cluster events periodically every 30 seconds
+// and save them to SQL database, kubernetes keeps them only for an hour
+func StartEventsMonitor(
+	clientset kubernetes.Interface, cluster string, db *gorm.DB,
+) error {
+
+	ticker := time.NewTicker(30 * time.Second)
+	go func() {
+		for range ticker.C {
+			// a failed collection is retried with the next tick
+			err := saveEventsToDB(clientset, cluster, db)
+			if err != nil {
+				log.Printf("collecting events of cluster %s failed: %v", cluster, err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func GetEventHistory(
+	cluster *Cluster, db *gorm.DB,
+	req *models.GetEventHistoryRequestModel,
+	starttime *time.Time, endtime *time.Time,
+) (models.ListEventsResponseModel, error) {
+
+	err := requireList(cluster, "", "events", req.Namespace)
+	if err != nil {
+		return models.ListEventsResponseModel{}, err
+	}
+
+	dbtx := db.Model(&models.DBEventModel{}).Where("cluster = ?", cluster.Name)
+
+	if starttime != nil && endtime != nil && starttime.After(*endtime) {
+		return models.ListEventsResponseModel{}, fmt.Errorf(
+			"%w: start_time cannot be after end_time", ErrInvalidRequest,
+		)
+	}
+	if starttime != nil {
+		dbtx = dbtx.Where("last_timestamp >= ?", starttime)
+	}
+	if endtime != nil {
+		dbtx = dbtx.Where("last_timestamp <= ?", endtime)
+	}
+
+	if req.Namespace != "" {
+		dbtx = dbtx.Where("namespace = ?", req.Namespace)
+	}
+	if req.InvolvedKind != "" {
+		dbtx = dbtx.Where("involved_kind = ?", req.InvolvedKind)
+	}
+	if req.InvolvedName != "" {
+		dbtx = dbtx.Where("involved_name = ?", req.InvolvedName)
+	}
+	if req.Type != "" {
+		dbtx = dbtx.Where("type = ?", req.Type)
+	}
+	if req.Reason != "" {
+		dbtx = dbtx.Where("reason = ?", req.Reason)
+	}
+
+	limit := eventHistoryDefaultLimit
+	if req.Limit != 0 {
+		limit = req.Limit
+	}
+
+	resp := models.ListEventsResponseModel{}
+	resp.Events = []models.DBEventModel{}
+	err = dbtx.Order("last_timestamp DESC").Limit(limit).Find(&resp.Events).Error
+	if err != nil {
+		return models.ListEventsResponseModel{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"path"
+	"slices"
+	"sort"
+	"time"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	ExportFormatTar = "tar"
+	ExportFormatZip = "zip"
+)
+
+// resources whose objects are generated by the cluster at runtime,
+// re-applying them elsewhere makes no sense
+var exportSkippedResources = map[schema.GroupResource]bool{
+	{Group: "", Resource: "events"}:                                        true,
+	{Group: "events.k8s.io", Resource: "events"}:                           true,
+	{Group: "", Resource: "endpoints"}:                                     true,
+	{Group: "discovery.k8s.io", Resource: "endpointslices"}:                true,
+	{Group: "apps", Resource: "controllerrevisions"}:                       true,
+	{Group: "coordination.k8s.io", Resource: "leases"}:                     true,
+	{Group: "authorization.k8s.io", Resource: "localsubjectaccessreviews"}: true,
+}
+
+// annotations maintained by kubectl and controllers
+var exportStrippedAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.beta.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/selected-node",
+}
+
+// copy of the object without status and every field assigned by the cluster,
+// namespace is dropped as well so the result can be applied to another
+// namespace or cluster
+func cleanObject(object *unstructured.Unstructured) *unstructured.Unstructured {
+
+	cleaned := normalizeObject(object)
+	for _, field := range []string{
+		"namespace", "uid", "creationTimestamp", "selfLink", "ownerReferences",
+		"deletionTimestamp", "deletionGracePeriodSeconds",
+	} {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", field)
+	}
+
+	annotations := cleaned.GetAnnotations()
+	for _, annotation := range exportStrippedAnnotations {
+		delete(annotations, annotation)
+	}
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", "annotations")
+	} else {
+		cleaned.SetAnnotations(annotations)
+	}
+
+	// pod templates of workloads are serialized with creationTimestamp: null
+	unstructured.RemoveNestedField(cleaned.Object, "spec", "template", "metadata", "creationTimestamp")
+
+	gvk := cleaned.GroupVersionKind()
+	switch gvk.GroupKind() {
+	case schema.GroupKind{Group: "", Kind: "Service"}:
+		// headless services keep clusterIP: None, they'd get an address otherwise
+		clusterIP, _, _ := unstructured.NestedString(cleaned.Object, "spec", "clusterIP")
+		if clusterIP != coreapiv1.ClusterIPNone {
+			unstructured.RemoveNestedField(cleaned.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(cleaned.Object, "spec", "clusterIPs")
+		}
+		unstructured.RemoveNestedField(cleaned.Object, "spec", "healthCheckNodePort")
+		// node ports are unique in the cluster, the copy would conflict
+		ports, found, _ := unstructured.NestedSlice(cleaned.Object, "spec", "ports")
+		if found {
+			for _, port := range ports {
+				if portMap, ok := port.(map[string]interface{}); ok {
+					delete(portMap, "nodePort")
+				}
+			}
+			_ = unstructured.SetNestedSlice(cleaned.Object, ports, "spec", "ports")
+		}
+	case schema.GroupKind{Group: "", Kind: "PersistentVolumeClaim"}:
+		unstructured.RemoveNestedField(cleaned.Object, "spec", "volumeName")
+	case schema.GroupKind{Group: "", Kind: "Pod"}:
+		unstructured.RemoveNestedField(cleaned.Object, "spec", "nodeName")
+	case schema.GroupKind{Group: "batch", Kind: "Job"}:
+		// selector and its labels are generated from the job uid
+		unstructured.RemoveNestedField(cleaned.Object, "spec", "selector")
+		for _, label := range []string{"controller-uid", "batch.kubernetes.io/controller-uid"} {
+			unstructured.RemoveNestedField(cleaned.Object, "metadata", "labels", label)
+			unstructured.RemoveNestedField(cleaned.Object, "spec", "template", "metadata", "labels", label)
+		}
+	}
+
+	return cleaned
+}
+
+// objects created by the cluster itself or by other objects of the namespace
+func isGeneratedObject(object *unstructured.Unstructured) bool {
+
+	if metaapiv1.GetControllerOfNoCopy(object) != nil {
+		return true
+	}
+
+	switch object.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "", Kind: "Secret"}:
+		secretType, _, _ := unstructured.NestedString(object.Object, "type")
+		return secretType == "kubernetes.io/service-account-token"
+	case schema.GroupKind{Group: "", Kind: "ConfigMap"}:
+		return object.GetName() == "kube-root-ca.crt"
+	case schema.GroupKind{Group: "", Kind: "ServiceAccount"}:
+		return object.GetName() == "default"
+	}
+
+	return false
+}
+
+// all objects of the namespace which were created by users, resources
+// which can't be listed (ex. forbidden) are skipped
+func (dc *DynamicClient) listNamespaceObjects(namespace string) ([]*unstructured.Unstructured, error) {
+
+	all, err := dc.listObjects(namespace, func(gvr schema.GroupVersionResource, verbs []string) bool {
+		return slices.Contains(verbs, "create") && !exportSkippedResources[gvr.GroupResource()]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, object := range all {
+		if !isGeneratedObject(object) {
+			objects = append(objects, object)
+		}
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		if objects[i].GetKind() != objects[j].GetKind() {
+			return objects[i].GetKind() < objects[j].GetKind()
+		}
+		return objects[i].GetName() < objects[j].GetName()
+	})
+
+	return objects, nil
+}
+
+func ExportResource(
+	dc *DynamicClient,
+	req *models.ExportResourceRequestModel,
+) ([]byte, error) {
+
+	mapping, err := dc.resolveResource(req.Group, req.Version, req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNamespaceGiven(mapping, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := dc.resourceInterface(mapping, req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(cleanObject(object).Object)
+
+}
+
+// write every user created object of the namespace as separate YAML file
+// (<namespace>/<resource>.<group>/<name>.yaml) into tar.gz or zip archive
+func ExportNamespace(
+	dc *DynamicClient,
+	req *models.ExportNamespaceRequestModel,
+	w io.Writer,
+) error {
+
+	objects, err := dc.listNamespaceObjects(req.Namespace)
+	if err != nil {
+		return err
+	}
+
+	type exportFile struct {
+		name    string
+		content []byte
+	}
+	files := []exportFile{}
+	for _, object := range objects {
+		mapping, err := dc.objectMapping(object, req.Namespace)
+		if err != nil {
+			return err
+		}
+		directory := mapping.Resource.Resource
+		if mapping.Resource.Group != "" {
+			directory += "." + mapping.Resource.Group
+		}
+
+		content, err := yaml.Marshal(cleanObject(object).Object)
+		if err != nil {
+			return err
+		}
+		files = append(files, exportFile{
+			name:    path.Join(req.Namespace, directory, object.GetName()+".yaml"),
+			content: content,
+		})
+	}
+
+	modified := time.Now()
+	if req.Format == ExportFormatZip {
+		archive := zip.NewWriter(w)
+		for _, file := range files {
+			fileWriter, err := archive.CreateHeader(&zip.FileHeader{
+				Name: file.name, Method: zip.Deflate, Modified: modified,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = fileWriter.Write(file.content)
+			if err != nil {
+				return err
+			}
+		}
+		return archive.Close()
+	}
+
+	compressed := gzip.NewWriter(w)
+	archive := tar.NewWriter(compressed)
+	for _, file := range files {
+		err = archive.WriteHeader(&tar.Header{
+			Name:    file.name,
+			Mode:    0644,
+			Size:    int64(len(file.content)),
+			ModTime: modified,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = archive.Write(file.content)
+		if err != nil {
+			return err
+		}
+	}
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+
+	return compressed.Close()
+
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCleanService(t *testing.T) {
+
+	service := func(spec map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": "db", "namespace": "payments", "uid": "uid-db"},
+			"spec":       spec,
+		}}
+	}
+
+	tests := []struct {
+		name    string
+		spec    map[string]interface{}
+		cleaned map[string]interface{}
+	}{
+		{
+			name: "cluster IP assigned by the cluster",
+			spec: map[string]interface{}{
+				"type":       "ClusterIP",
+				"clusterIP":  "10.96.12.7",
+				"clusterIPs": []interface{}{"10.96.12.7"},
+				"ports":      []interface{}{map[string]interface{}{"port": int64(5432)}},
+			},
+			cleaned: map[string]interface{}{
+				"type":  "ClusterIP",
+				"ports": []interface{}{map[string]interface{}{"port": int64(5432)}},
+			},
+		},
+		{
+			name: "headless",
+			spec: map[string]interface{}{
+				"type":       "ClusterIP",
+				"clusterIP":  "None",
+				"clusterIPs": []interface{}{"None"},
+				"ports":      []interface{}{map[string]interface{}{"port": int64(5432)}},
+			},
+			cleaned: map[string]interface{}{
+				"type":       "ClusterIP",
+				"clusterIP":  "None",
+				"clusterIPs": []interface{}{"None"},
+				"ports":      []interface{}{map[string]interface{}{"port": int64(5432)}},
+			},
+		},
+		{
+			name: "node port",
+			spec: map[string]interface{}{
+				"type":      "NodePort",
+				"clusterIP": "10.96.12.8",
+				"ports":     []interface{}{map[string]interface{}{"port": int64(80), "nodePort": int64(30080)}},
+			},
+			cleaned: map[string]interface{}{
+				"type":  "NodePort",
+				"ports": []interface{}{map[string]interface{}{"port": int64(80)}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cleaned := cleanObject(service(test.spec))
+			spec, _, _ := unstructured.NestedMap(cleaned.Object, "spec")
+			if !reflect.DeepEqual(spec, test.cleaned) {
+				t.Fatalf("expected spec %v, got %v", test.cleaned, spec)
+			}
+			if cleaned.GetNamespace() != "" || cleaned.GetUID() != "" {
+				t.Fatalf("expected namespace and uid removed, got %v", cleaned.Object["metadata"])
+			}
+		})
+	}
+}
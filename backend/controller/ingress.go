@@ -0,0 +1,272 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	networkingapiv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// make sure every path points to an existing service and one of its ports
+func validateIngressBackends(
+	clientset kubernetes.Interface, namespace string,
+	rules []models.IngressRuleModel,
+) error {
+
+	// services are looked up only once even if referenced by many paths
+	services := map[string]*coreapiv1.Service{}
+
+	for _, rule := range rules {
+		for _, path := range rule.Paths {
+
+			service, ok := services[path.ServiceName]
+			if !ok {
+				var err error
+				service, err = clientset.CoreV1().Services(namespace).Get(
+					context.TODO(), path.ServiceName, metaapiv1.GetOptions{},
+				)
+				if apierrors.IsNotFound(err) {
+					return fmt.Errorf(
+						"%w: service %q not found in namespace %q",
+						ErrInvalidRequest, path.ServiceName, namespace,
+					)
+				}
+				if err != nil {
+					return err
+				}
+				services[path.ServiceName] = service
+			}
+
+			portFound := false
+			for _, port := range service.Spec.Ports {
+				if (path.ServicePortName != "" && port.Name == path.ServicePortName) ||
+					(path.ServicePortName == "" && port.Port == path.ServicePort) {
+					portFound = true
+					break
+				}
+			}
+			if !portFound {
+				portDesc := path.ServicePortName
+				if portDesc == "" {
+					portDesc = fmt.Sprint(path.ServicePort)
+				}
+				return fmt.Errorf(
+					"%w: service %q has no port %s",
+					ErrInvalidRequest, path.ServiceName, portDesc,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// convert request rules and tls into the ingress spec
+func buildIngressSpec(
+	ingressClass string,
+	rules []models.IngressRuleModel, tls []models.IngressTLSModel,
+) networkingapiv1.IngressSpec {
+
+	spec := networkingapiv1.IngressSpec{}
+	if ingressClass != "" {
+		spec.IngressClassName = &ingressClass
+	}
+
+	for _, rule := range rules {
+		ingressRule := networkingapiv1.IngressRule{
+			Host: rule.Host,
+			IngressRuleValue: networkingapiv1.IngressRuleValue{
+				HTTP: &networkingapiv1.HTTPIngressRuleValue{},
+			},
+		}
+
+		for _, path := range rule.Paths {
+			pathType := networkingapiv1.PathTypePrefix
+			if path.PathType != "" {
+				pathType = networkingapiv1.PathType(path.PathType)
+			}
+
+			backendPort := networkingapiv1.ServiceBackendPort{}
+			if path.ServicePortName != "" {
+				backendPort.Name = path.ServicePortName
+			} else {
+				backendPort.Number = path.ServicePort
+			}
+
+			ingressRule.HTTP.Paths = append(
+				ingressRule.HTTP.Paths,
+				networkingapiv1.HTTPIngressPath{
+					Path:     path.Path,
+					PathType: &pathType,
+					Backend: networkingapiv1.IngressBackend{
+						Service: &networkingapiv1.IngressServiceBackend{
+							Name: path.ServiceName,
+							Port: backendPort,
+						},
+					},
+				},
+			)
+		}
+
+		spec.Rules = append(spec.Rules, ingressRule)
+	}
+
+	for _, t := range tls {
+		spec.TLS = append(spec.TLS, networkingapiv1.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+
+	return spec
+}
+
+func ListIngresses(
+	clientset kubernetes.Interface,
+	req *models.ListIngressesRequestModel,
+) (models.ListIngressesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListIngressesResponseModel{}, err
+	}
+	ingresses, err := clientset.NetworkingV1().Ingresses(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListIngressesResponseModel{}, listError(err)
+	}
+	err = sortObjects(ingresses.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListIngressesResponseModel{}, err
+	}
+
+	resp := models.ListIngressesResponseModel{}
+	resp.Ingresses = []models.ListIngressesResponseModelIngress{}
+	for _, ingressdata := range ingresses.Items {
+		currentIngress := models.ListIngressesResponseModelIngress{}
+		currentIngress.Name = ingressdata.Name
+		currentIngress.Namespace = ingressdata.Namespace
+		if ingressdata.Spec.IngressClassName != nil {
+			currentIngress.IngressClass = *ingressdata.Spec.IngressClassName
+		}
+		currentIngress.CreationTime =
+			ingressdata.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+
+		currentIngress.Rules = []models.IngressRuleModel{}
+		for _, ruledata := range ingressdata.Spec.Rules {
+			currentRule := models.IngressRuleModel{Host: ruledata.Host}
+			currentRule.Paths = []models.IngressPathModel{}
+			if ruledata.HTTP != nil {
+				for _, pathdata := range ruledata.HTTP.Paths {
+					currentPath := models.IngressPathModel{Path: pathdata.Path}
+					if pathdata.PathType != nil {
+						currentPath.PathType = string(*pathdata.PathType)
+					}
+					if pathdata.Backend.Service != nil {
+						currentPath.ServiceName = pathdata.Backend.Service.Name
+						currentPath.ServicePort = pathdata.Backend.Service.Port.Number
+						currentPath.ServicePortName = pathdata.Backend.Service.Port.Name
+					}
+					currentRule.Paths = append(currentRule.Paths, currentPath)
+				}
+			}
+			currentIngress.Rules = append(currentIngress.Rules, currentRule)
+		}
+
+		currentIngress.TLS = []models.IngressTLSModel{}
+		for _, tlsdata := range ingressdata.Spec.TLS {
+			currentIngress.TLS = append(currentIngress.TLS, models.IngressTLSModel{
+				Hosts:      tlsdata.Hosts,
+				SecretName: tlsdata.SecretName,
+			})
+		}
+
+		// load balancer may report either IP or hostname
+		currentIngress.Addresses = []string{}
+		for _, lbdata := range ingressdata.Status.LoadBalancer.Ingress {
+			if lbdata.IP != "" {
+				currentIngress.Addresses = append(currentIngress.Addresses, lbdata.IP)
+			}
+			if lbdata.Hostname != "" {
+				currentIngress.Addresses = append(currentIngress.Addresses, lbdata.Hostname)
+			}
+		}
+
+		resp.Ingresses = append(resp.Ingresses, currentIngress)
+	}
+	resp.Pagination = pagination(ingresses.ListMeta, len(resp.Ingresses))
+
+	return resp, nil
+
+}
+
+func CreateIngress(
+	clientset kubernetes.Interface,
+	req *models.CreateIngressRequestModel,
+) error {
+
+	err := validateIngressBackends(clientset, req.Namespace, req.Rules)
+	if err != nil {
+		return err
+	}
+
+	ingress := &networkingapiv1.Ingress{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+		Spec: buildIngressSpec(req.IngressClass, req.Rules, req.TLS),
+	}
+
+	_, err = clientset.NetworkingV1().Ingresses(req.Namespace).Create(
+		context.TODO(), ingress, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdateIngress(
+	clientset kubernetes.Interface,
+	req *models.UpdateIngressRequestModel,
+) error {
+
+	ingress, err := clientset.NetworkingV1().Ingresses(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = validateIngressBackends(clientset, req.Namespace, req.Rules)
+	if err != nil {
+		return err
+	}
+
+	// replace the whole spec, keep the metadata (labels, annotations) intact
+	ingress.Spec = buildIngressSpec(req.IngressClass, req.Rules, req.TLS)
+
+	_, err = clientset.NetworkingV1().Ingresses(req.Namespace).Update(
+		context.TODO(), ingress, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+func DeleteIngress(
+	clientset kubernetes.Interface,
+	req *models.DeleteIngressRequestModel,
+) error {
+
+	err := clientset.NetworkingV1().Ingresses(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
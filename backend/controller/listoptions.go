@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	SortByName     = "name"
+	SortByAge      = "age"
+	SortByStatus   = "status"
+	SortByRestarts = "restarts"
+)
+
+const SortOrderDesc = "desc"
+
+// build list options for the API server, selectors are parsed here
+// so mistakes are reported as bad request, nil options list everything
+func listOptions(opts *models.ListOptionsModel) (metaapiv1.ListOptions, error) {
+
+	if opts == nil {
+		return metaapiv1.ListOptions{}, nil
+	}
+
+	_, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return metaapiv1.ListOptions{}, fmt.Errorf(
+			"%w: invalid label selector: %v", ErrInvalidRequest, err,
+		)
+	}
+	_, err = fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return metaapiv1.ListOptions{}, fmt.Errorf(
+			"%w: invalid field selector: %v", ErrInvalidRequest, err,
+		)
+	}
+
+	return metaapiv1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	}, nil
+}
+
+// the cache can filter only by labels, field selectors and pages
+// have to be served by the API server
+func cacheableOptions(opts *models.ListOptionsModel) bool {
+	return opts == nil || (opts.FieldSelector == "" && opts.Limit == 0 && opts.Continue == "")
+}
+
+// unsupported field selectors and expired continue tokens are
+// mistakes of the request, not of the server
+func listError(err error) error {
+
+	if apierrors.IsBadRequest(err) || apierrors.IsResourceExpired(err) {
+		return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	return err
+}
+
+func pagination(listMeta metaapiv1.ListMeta, count int) models.PaginationModel {
+	return models.PaginationModel{
+		Count:              count,
+		Continue:           listMeta.Continue,
+		RemainingItemCount: listMeta.RemainingItemCount,
+	}
+}
+
+// sort key of the request and whether it's descending, empty key
+// keeps the order of the list (namespace and name)
+func sortOrder(opts *models.ListOptionsModel) (string, bool) {
+
+	if opts == nil || (opts.SortBy == "" && opts.Order == "") {
+		return "", false
+	}
+	if opts.SortBy == "" {
+		return SortByName, opts.Order == SortOrderDesc
+	}
+
+	return opts.SortBy, opts.Order == SortOrderDesc
+}
+
+func sortNotSupported(sortBy string) error {
+	return fmt.Errorf("%w: objects of the resource can't be sorted by %s", ErrInvalidRequest, sortBy)
+}
+
+// sort objects of the page by the requested key, status and restarts are nil
+// for kinds which don't have them, objects with equal keys keep their order
+func sortObjects[T any, PT interface {
+	*T
+	metaapiv1.Object
+}](
+	items []T,
+	opts *models.ListOptionsModel,
+	status func(object PT) string,
+	restarts func(object PT) int64,
+) error {
+
+	sortBy, desc := sortOrder(opts)
+
+	var less func(a PT, b PT) bool
+	switch sortBy {
+	case "":
+		return nil
+	case SortByName:
+		less = func(a PT, b PT) bool {
+			return a.GetName() < b.GetName()
+		}
+	case SortByAge:
+		// younger objects have lower age
+		less = func(a PT, b PT) bool {
+			return a.GetCreationTimestamp().Time.After(b.GetCreationTimestamp().Time)
+		}
+	case SortByStatus:
+		if status == nil {
+			return sortNotSupported(sortBy)
+		}
+		less = func(a PT, b PT) bool {
+			return status(a) < status(b)
+		}
+	case SortByRestarts:
+		if restarts == nil {
+			return sortNotSupported(sortBy)
+		}
+		less = func(a PT, b PT) bool {
+			return restarts(a) < restarts(b)
+		}
+	default:
+		return sortNotSupported(sortBy)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if desc {
+			return less(&items[j], &items[i])
+		}
+		return less(&items[i], &items[j])
+	})
+
+	return nil
+}
+
+// restarts cell is a number or a string like "3 (5m ago)"
+func tableCellInt(cell interface{}) int64 {
+
+	switch value := cell.(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	case string:
+		fields := strings.Fields(value)
+		if len(fields) > 0 {
+			number, _ := strconv.ParseInt(fields[0], 10, 64)
+			return number
+		}
+	}
+
+	return 0
+}
+
+func tableRowCreationTime(row *models.ResourceTableRowModel) string {
+
+	metadata, _ := row.Object["metadata"].(map[string]interface{})
+	creationTime, _ := metadata["creationTimestamp"].(string)
+
+	return creationTime
+}
+
+// sort table rows like sortObjects, status and restarts come from
+// the columns with these names when the resource has them
+func sortTableRows(
+	rows []models.ResourceTableRowModel,
+	columns []models.ResourceTableColumnModel,
+	opts *models.ListOptionsModel,
+) error {
+
+	sortBy, desc := sortOrder(opts)
+
+	column := -1
+	for i := range columns {
+		if strings.EqualFold(columns[i].Name, sortBy) {
+			column = i
+		}
+	}
+	cell := func(row *models.ResourceTableRowModel) interface{} {
+		if column < len(row.Cells) {
+			return row.Cells[column]
+		}
+		return nil
+	}
+
+	var less func(a *models.ResourceTableRowModel, b *models.ResourceTableRowModel) bool
+	switch sortBy {
+	case "":
+		return nil
+	case SortByName:
+		less = func(a *models.ResourceTableRowModel, b *models.ResourceTableRowModel) bool {
+			return a.Name < b.Name
+		}
+	case SortByAge:
+		// timestamps are in RFC3339 (UTC) and compare as strings
+		less = func(a *models.ResourceTableRowModel, b *models.ResourceTableRowModel) bool {
+			return tableRowCreationTime(a) > tableRowCreationTime(b)
+		}
+	case SortByStatus:
+		if column == -1 {
+			return sortNotSupported(sortBy)
+		}
+		less = func(a *models.ResourceTableRowModel, b *models.ResourceTableRowModel) bool {
+			return fmt.Sprint(cell(a)) < fmt.Sprint(cell(b))
+		}
+	case SortByRestarts:
+		if column == -1 {
+			return sortNotSupported(sortBy)
+		}
+		less = func(a *models.ResourceTableRowModel, b *models.ResourceTableRowModel) bool {
+			return tableCellInt(cell(a)) < tableCellInt(cell(b))
+		}
+	default:
+		return sortNotSupported(sortBy)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if desc {
+			return less(&rows[j], &rows[i])
+		}
+		return less(&rows[i], &rows[j])
+	})
+
+	return nil
+}
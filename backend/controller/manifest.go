@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// field manager recorded in managedFields of objects applied by kubedash
+const FieldManager = "kubedash"
+
+const (
+	ApplyResultCreated    = "created"
+	ApplyResultConfigured = "configured"
+	ApplyResultUnchanged  = "unchanged"
+	ApplyResultError      = "error"
+)
+
+// split multi document YAML (or JSON stream) into objects,
+// List kinds are flattened into their items
+func decodeManifests(manifest string) ([]*unstructured.Unstructured, error) {
+
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	objects := []*unstructured.Unstructured{}
+	for {
+		object := &unstructured.Unstructured{}
+		err := decoder.Decode(&object.Object)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%w: unable to decode manifest: %v", ErrInvalidRequest, err,
+			)
+		}
+
+		// empty documents (ex. trailing ---)
+		if len(object.Object) == 0 {
+			continue
+		}
+
+		if object.IsList() {
+			err = object.EachListItem(func(item runtime.Object) error {
+				objects = append(objects, item.(*unstructured.Unstructured))
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+			}
+			continue
+		}
+
+		if object.GetKind() == "" || object.GetAPIVersion() == "" {
+			return nil, fmt.Errorf(
+				"%w: every object needs apiVersion and kind", ErrInvalidRequest,
+			)
+		}
+		if object.GetName() == "" {
+			return nil, fmt.Errorf(
+				"%w: %s without metadata.name", ErrInvalidRequest, object.GetKind(),
+			)
+		}
+
+		objects = append(objects, object)
+	}
+
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("%w: manifest contains no objects", ErrInvalidRequest)
+	}
+
+	return objects, nil
+}
+
+// copy of the object without fields which change on every write
+// or are not set by the user, used to tell if anything really changed
+func normalizeObject(object *unstructured.Unstructured) *unstructured.Unstructured {
+
+	normalized := object.DeepCopy()
+	unstructured.RemoveNestedField(normalized.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(normalized.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(normalized.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(normalized.Object, "status")
+
+	return normalized
+}
+
+// resolve the object kind and client for it, namespace of the object
+// is filled in when missing
+func (dc *DynamicClient) objectMapping(
+	object *unstructured.Unstructured, defaultNamespace string,
+) (*meta.RESTMapping, error) {
+
+	gvk := object.GroupVersionKind()
+	mapping, err := dc.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if object.GetNamespace() == "" {
+			object.SetNamespace(defaultNamespace)
+		}
+	} else {
+		object.SetNamespace("")
+	}
+
+	return mapping, nil
+}
+
+// server-side apply single object, live is the object before the apply
+// (nil if it didn't exist) and applied the result returned by the server
+func (dc *DynamicClient) applyObject(
+	object *unstructured.Unstructured, defaultNamespace string,
+	dryRun bool, force bool,
+) (live *unstructured.Unstructured, applied *unstructured.Unstructured, err error) {
+
+	mapping, err := dc.objectMapping(object, defaultNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := dc.resourceInterface(mapping, object.GetNamespace())
+
+	live, err = client.Get(context.TODO(), object.GetName(), metaapiv1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		live = nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	options := metaapiv1.ApplyOptions{FieldManager: FieldManager, Force: force}
+	if dryRun {
+		options.DryRun = []string{metaapiv1.DryRunAll}
+	}
+
+	applied, err = client.Apply(context.TODO(), object.GetName(), object, options)
+	if err != nil {
+		return live, nil, err
+	}
+
+	return live, applied, nil
+}
+
+func applyResult(live *unstructured.Unstructured, applied *unstructured.Unstructured) string {
+
+	if live == nil {
+		return ApplyResultCreated
+	}
+	if equality.Semantic.DeepEqual(normalizeObject(live), normalizeObject(applied)) {
+		return ApplyResultUnchanged
+	}
+
+	return ApplyResultConfigured
+}
+
+func ApplyManifests(
+	dc *DynamicClient,
+	req *models.ApplyManifestsRequestModel,
+) (models.ApplyManifestsResponseModel, error) {
+
+	objects, err := decodeManifests(req.Manifest)
+	if err != nil {
+		return models.ApplyManifestsResponseModel{}, err
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = metaapiv1.NamespaceDefault
+	}
+	// diff is computed from the server-side dry run result
+	dryRun := req.DryRun == "server" || req.Diff
+
+	resp := models.ApplyManifestsResponseModel{DryRun: dryRun}
+	resp.Objects = []models.ApplyManifestsResponseModelObject{}
+
+	// objects are applied one by one, failure of one doesn't stop the others
+	for _, object := range objects {
+		live, applied, err := dc.applyObject(object, namespace, dryRun, req.Force)
+
+		currentObject := models.ApplyManifestsResponseModelObject{
+			APIVersion: object.GetAPIVersion(),
+			Kind:       object.GetKind(),
+			Name:       object.GetName(),
+			Namespace:  object.GetNamespace(),
+		}
+		if err != nil {
+			currentObject.Result = ApplyResultError
+			currentObject.Error = err.Error()
+		} else {
+			currentObject.Result = applyResult(live, applied)
+			if req.Diff {
+				diff, err := diffObjects(object.GetKind(), live, applied)
+				if err != nil {
+					return models.ApplyManifestsResponseModel{}, err
+				}
+				currentObject.Diff = &diff
+			}
+		}
+
+		resp.Objects = append(resp.Objects, currentObject)
+	}
+
+	return resp, nil
+
+}
@@ -0,0 +1,251 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	// default length of the aggregation intervals
+	workloadMetricsDefaultInterval = time.Minute
+	// metrics are collected every 5 seconds, shorter intervals would be empty
+	workloadMetricsMinInterval = 5 * time.Second
+	// aggregated time range is limited like the one of a single pod
+	workloadMetricsMaxRange = 72 * time.Hour
+	// limits the size of the response
+	workloadMetricsMaxIntervals = 1000
+)
+
+// usage of one pod in one metrics collection
+type podUsageSample struct {
+	RecordID     uint
+	CreatedAt    time.Time
+	Namespace    string
+	WorkloadKind string
+	WorkloadName string
+	CPU          int64
+	Memory       int64
+}
+
+// usage of the pods of a group in one collection
+type groupSample struct {
+	pods   int
+	cpu    int64
+	memory int64
+}
+
+// collections of a group in one interval
+type groupInterval struct {
+	samples   map[uint]*groupSample
+	cpuMax    int64
+	memoryMax int64
+}
+
+// workload or namespace the usage is aggregated for
+type usageGroup struct {
+	namespace string
+	kind      string
+	name      string
+}
+
+// group the pod belongs to, empty for pods which can't be grouped (without
+// workload or namespace, ex. records stored before they were recorded)
+func podUsageGroup(sample *podUsageSample, groupBy string) usageGroup {
+
+	if sample.Namespace == "" {
+		return usageGroup{}
+	}
+	if groupBy == "namespace" {
+		return usageGroup{namespace: sample.Namespace}
+	}
+	if sample.WorkloadKind == "" {
+		return usageGroup{}
+	}
+
+	return usageGroup{
+		namespace: sample.Namespace, kind: sample.WorkloadKind, name: sample.WorkloadName,
+	}
+}
+
+// aggregate usage of the pods per group and interval starting at start,
+// sum is the usage of all pods of the group and avg the usage of one pod,
+// both averaged over the collections, max is the highest usage of one pod
+func aggregateWorkloadMetrics(
+	samples []podUsageSample, groupBy string, start time.Time, interval time.Duration,
+) []models.WorkloadMetricsModel {
+
+	groups := map[usageGroup]map[int]*groupInterval{}
+	for i := range samples {
+		sample := &samples[i]
+		group := podUsageGroup(sample, groupBy)
+		if group.namespace == "" {
+			continue
+		}
+		if groups[group] == nil {
+			groups[group] = map[int]*groupInterval{}
+		}
+
+		bucket := int(sample.CreatedAt.Sub(start) / interval)
+		intervalUsage := groups[group][bucket]
+		if intervalUsage == nil {
+			intervalUsage = &groupInterval{samples: map[uint]*groupSample{}}
+			groups[group][bucket] = intervalUsage
+		}
+		collection := intervalUsage.samples[sample.RecordID]
+		if collection == nil {
+			collection = &groupSample{}
+			intervalUsage.samples[sample.RecordID] = collection
+		}
+		collection.pods++
+		collection.cpu += sample.CPU
+		collection.memory += sample.Memory
+		intervalUsage.cpuMax = max(intervalUsage.cpuMax, sample.CPU)
+		intervalUsage.memoryMax = max(intervalUsage.memoryMax, sample.Memory)
+	}
+
+	workloads := []models.WorkloadMetricsModel{}
+	for group, buckets := range groups {
+		indexes := []int{}
+		for bucket := range buckets {
+			indexes = append(indexes, bucket)
+		}
+		sort.Ints(indexes)
+
+		workload := models.WorkloadMetricsModel{
+			Namespace: group.namespace,
+			Kind:      group.kind,
+			Name:      group.name,
+			Intervals: []models.WorkloadMetricsIntervalModel{},
+		}
+		for _, bucket := range indexes {
+			intervalUsage := buckets[bucket]
+			summary := models.WorkloadMetricsIntervalModel{
+				Start:     start.Add(time.Duration(bucket) * interval).UTC().Format(time.RFC3339),
+				Samples:   len(intervalUsage.samples),
+				CPUMax:    intervalUsage.cpuMax,
+				MemoryMax: intervalUsage.memoryMax,
+			}
+			pods := 0
+			for _, collection := range intervalUsage.samples {
+				summary.Pods = max(summary.Pods, collection.pods)
+				summary.CPUSum += collection.cpu
+				summary.MemorySum += collection.memory
+				pods += collection.pods
+			}
+			summary.CPUAvg = summary.CPUSum / int64(pods)
+			summary.MemoryAvg = summary.MemorySum / int64(pods)
+			summary.CPUSum /= int64(summary.Samples)
+			summary.MemorySum /= int64(summary.Samples)
+			workload.Intervals = append(workload.Intervals, summary)
+		}
+		workloads = append(workloads, workload)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		a, b := workloads[i], workloads[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+
+	return workloads
+}
+
+// CPU and memory usage of workloads (or namespaces) aggregated per interval
+// from the stored pod metrics of the cluster
+func GetWorkloadMetrics(
+	cluster *Cluster, db *gorm.DB,
+	req *models.GetWorkloadMetricsRequestModel,
+	starttime time.Time, endtime time.Time,
+) (models.GetWorkloadMetricsResponseModel, error) {
+
+	interval := workloadMetricsDefaultInterval
+	if req.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(req.Interval)
+		if err != nil {
+			return models.GetWorkloadMetricsResponseModel{}, fmt.Errorf(
+				"%w: unable to parse interval", ErrInvalidRequest,
+			)
+		}
+	}
+	if interval < workloadMetricsMinInterval {
+		return models.GetWorkloadMetricsResponseModel{}, fmt.Errorf(
+			"%w: interval must be at least %s", ErrInvalidRequest, workloadMetricsMinInterval,
+		)
+	}
+	if starttime.After(endtime) {
+		return models.GetWorkloadMetricsResponseModel{}, fmt.Errorf(
+			"%w: start_time cannot be after end_time", ErrInvalidRequest,
+		)
+	}
+	if endtime.Sub(starttime) > workloadMetricsMaxRange {
+		return models.GetWorkloadMetricsResponseModel{}, fmt.Errorf(
+			"%w: time range too wide", ErrInvalidRequest,
+		)
+	}
+	if endtime.Sub(starttime)/interval >= workloadMetricsMaxIntervals {
+		return models.GetWorkloadMetricsResponseModel{}, fmt.Errorf(
+			"%w: too many intervals, use longer interval", ErrInvalidRequest,
+		)
+	}
+
+	err := requireList(cluster, "metrics.k8s.io", "pods", req.Namespace)
+	if err != nil {
+		return models.GetWorkloadMetricsResponseModel{}, err
+	}
+
+	conditions := []string{"r.cluster = ?", "r.created_at BETWEEN ? AND ?"}
+	args := []interface{}{cluster.Name, starttime, endtime}
+	for _, filter := range []struct {
+		column string
+		value  string
+	}{
+		{"p.namespace", req.Namespace},
+		{"p.workload_kind", req.WorkloadKind},
+		{"p.workload_name", req.WorkloadName},
+	} {
+		if filter.value != "" {
+			conditions = append(conditions, filter.column+" = ?")
+			args = append(args, filter.value)
+		}
+	}
+
+	// usage of the containers is summed up per pod in the database, every
+	// selected column is grouped as other databases than sqlite require it
+	var samples []podUsageSample
+	err = db.Table("db_cluster_metrics_models AS r").
+		Select(
+			"r.id AS record_id, r.created_at, p.namespace, p.workload_kind, p.workload_name, "+
+				"SUM(c.cpu_usage) AS cpu, SUM(c.memory_usage) AS memory",
+		).
+		Joins("JOIN db_pod_metrics_models AS p ON p.c_rec_id = r.id").
+		Joins("JOIN db_container_metrics_models AS c ON c.pod_id = p.id").
+		Where(strings.Join(conditions, " AND "), args...).
+		Group("p.id, r.id, r.created_at, p.namespace, p.workload_kind, p.workload_name").
+		Scan(&samples).
+		Error
+	if err != nil {
+		return models.GetWorkloadMetricsResponseModel{}, err
+	}
+
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = "workload"
+	}
+
+	return models.GetWorkloadMetricsResponseModel{
+		Interval:  interval.String(),
+		Workloads: aggregateWorkloadMetrics(samples, groupBy, starttime, interval),
+	}, nil
+}
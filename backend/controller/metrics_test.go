@@ -0,0 +1,612 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	appsapiv1 "k8s.io/api/apps/v1"
+	coreapiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	metricsapiv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	fakemetricsv "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	"github.com/kube-dash/kube-dash-backend/database"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// in-memory database of the test, shared cache keeps it alive
+// across the connections of the pool
+func newMetricsTestDB(t *testing.T) *gorm.DB {
+
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.ReplaceAll(t.Name(), "/", "_"))
+	db, err := database.InitDB(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		dbIns, _ := db.DB()
+		_ = dbIns.Close()
+	})
+
+	return db
+}
+
+// usage of the container in millicores and mebibytes
+func testContainerMetrics(name string, cpu int64, memory int64) metricsapiv1beta1.ContainerMetrics {
+	return metricsapiv1beta1.ContainerMetrics{
+		Name: name,
+		Usage: coreapiv1.ResourceList{
+			coreapiv1.ResourceCPU:    *resource.NewMilliQuantity(cpu, resource.DecimalSI),
+			coreapiv1.ResourceMemory: *resource.NewQuantity(memory<<20, resource.BinarySI),
+		},
+	}
+}
+
+// cluster with nodes, pods of a deployment, a stateful set and a pod
+// without controller, and their usage reported by the metrics server
+func newMetricsTestCluster(t *testing.T) *Cluster {
+
+	t.Helper()
+
+	controllerRef := true
+	pod := func(namespace string, name string, ownerKind string, ownerName string) *coreapiv1.Pod {
+		pod := &coreapiv1.Pod{
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Namespace: namespace, Name: name, UID: types.UID("uid-" + name),
+				Labels: map[string]string{"pod-template-hash": "5d8"},
+			},
+			Spec: coreapiv1.PodSpec{NodeName: "worker-1"},
+		}
+		if ownerKind != "" {
+			pod.OwnerReferences = []metaapiv1.OwnerReference{{
+				Kind: ownerKind, Name: ownerName, Controller: &controllerRef,
+			}}
+		}
+		return pod
+	}
+
+	clientset := fakekubernetes.NewSimpleClientset(
+		&coreapiv1.Node{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "worker-1"},
+			Status: coreapiv1.NodeStatus{Allocatable: coreapiv1.ResourceList{
+				coreapiv1.ResourceCPU:    resource.MustParse("3800m"),
+				coreapiv1.ResourceMemory: resource.MustParse("7Gi"),
+			}},
+		},
+		pod("default", "nginx-5d8-abcde", "ReplicaSet", "nginx-5d8"),
+		pod("default", "nginx-5d8-fghij", "ReplicaSet", "nginx-5d8"),
+		pod("default", "debug", "", ""),
+		pod("payments", "db-0", "StatefulSet", "db"),
+		&appsapiv1.ReplicaSet{ObjectMeta: metaapiv1.ObjectMeta{
+			Namespace: "default", Name: "nginx-5d8",
+			OwnerReferences: []metaapiv1.OwnerReference{{
+				Kind: "Deployment", Name: "nginx", Controller: &controllerRef,
+			}},
+		}},
+	)
+
+	// objects of the fake metrics clientset are listed only when they're
+	// tracked under the resource of the metrics API
+	metricsset := fakemetricsv.NewSimpleClientset()
+	track := func(resource string, namespace string, object runtime.Object) {
+		gvr := schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: resource}
+		if err := metricsset.Tracker().Create(gvr, object, namespace); err != nil {
+			t.Fatal(err)
+		}
+	}
+	track("nodes", "", &metricsapiv1beta1.NodeMetrics{
+		ObjectMeta: metaapiv1.ObjectMeta{Name: "worker-1"},
+		Timestamp:  metaapiv1.Now(),
+		Usage: coreapiv1.ResourceList{
+			coreapiv1.ResourceCPU:    resource.MustParse("1250m"),
+			coreapiv1.ResourceMemory: resource.MustParse("3Gi"),
+		},
+	})
+	for _, podMetrics := range []*metricsapiv1beta1.PodMetrics{
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Namespace: "default", Name: "nginx-5d8-abcde"},
+			Containers: []metricsapiv1beta1.ContainerMetrics{
+				testContainerMetrics("nginx", 80, 48), testContainerMetrics("sidecar", 20, 16),
+			},
+		},
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Namespace: "default", Name: "nginx-5d8-fghij"},
+			Containers: []metricsapiv1beta1.ContainerMetrics{testContainerMetrics("nginx", 200, 128)},
+		},
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Namespace: "default", Name: "debug"},
+			Containers: []metricsapiv1beta1.ContainerMetrics{testContainerMetrics("shell", 10, 16)},
+		},
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Namespace: "payments", Name: "db-0"},
+			Containers: []metricsapiv1beta1.ContainerMetrics{testContainerMetrics("postgres", 400, 1024)},
+		},
+	} {
+		track("pods", podMetrics.Namespace, podMetrics)
+	}
+
+	return NewCluster("test", clientset, metricsset, nil)
+}
+
+// names of the pods in the records
+func recordedPods(records []models.DBClusterMetricsModel) []string {
+
+	names := []string{}
+	for _, record := range records {
+		for _, pod := range record.Pods {
+			names = append(names, pod.Namespace+"/"+pod.Name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func TestPodWorkload(t *testing.T) {
+
+	controllerRef := true
+	ownedBy := func(kind string, name string, uid string) []metaapiv1.OwnerReference {
+		return []metaapiv1.OwnerReference{{
+			Kind: kind, Name: name, UID: types.UID(uid), Controller: &controllerRef,
+		}}
+	}
+	replicaSets := replicaSetsByName([]appsapiv1.ReplicaSet{
+		{ObjectMeta: metaapiv1.ObjectMeta{
+			Namespace: "default", Name: "web-blue", UID: "web-blue-uid",
+			OwnerReferences: ownedBy("Deployment", "frontend", "frontend-uid"),
+		}},
+		{ObjectMeta: metaapiv1.ObjectMeta{Namespace: "default", Name: "api-5d8", UID: "api-5d8-uid"}},
+		{ObjectMeta: metaapiv1.ObjectMeta{
+			Namespace: "default", Name: "canary-7f9", UID: "canary-7f9-uid",
+			OwnerReferences: ownedBy("Rollout", "canary", "canary-uid"),
+		}},
+	})
+
+	tests := []struct {
+		name   string
+		owners []metaapiv1.OwnerReference
+		kind   string
+		owner  string
+	}{
+		{"deployment with custom replica set name", ownedBy("ReplicaSet", "web-blue", "web-blue-uid"), "Deployment", "frontend"},
+		{"replica set without owner", ownedBy("ReplicaSet", "api-5d8", "api-5d8-uid"), "ReplicaSet", "api-5d8"},
+		{"replica set of other controller", ownedBy("ReplicaSet", "canary-7f9", "canary-7f9-uid"), "Rollout", "canary"},
+		{"recreated replica set", ownedBy("ReplicaSet", "web-blue", "old-uid"), "ReplicaSet", "web-blue"},
+		{"unknown replica set", ownedBy("ReplicaSet", "nginx-5d8", "nginx-5d8-uid"), "ReplicaSet", "nginx-5d8"},
+		{"stateful set", ownedBy("StatefulSet", "db", "db-uid"), "StatefulSet", "db"},
+		{"without controller", nil, "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := &coreapiv1.Pod{ObjectMeta: metaapiv1.ObjectMeta{
+				Namespace: "default", Name: "pod", OwnerReferences: test.owners,
+				Labels: map[string]string{"pod-template-hash": "5d8"},
+			}}
+			kind, name := podWorkload(pod, replicaSets)
+			if kind != test.kind || name != test.owner {
+				t.Fatalf("expected %s %s, got %s %s", test.kind, test.owner, kind, name)
+			}
+		})
+	}
+}
+
+func TestPodMetrics(t *testing.T) {
+
+	db := newMetricsTestDB(t)
+	cluster := newMetricsTestCluster(t)
+
+	start := time.Now().Add(-time.Minute)
+	if err := savePodMetricsToDB(cluster, db); err != nil {
+		t.Fatal(err)
+	}
+	end := time.Now().Add(time.Minute)
+
+	records, err := GetPodMetricsV2(cluster, db, "%", &models.GetPodMetricsV2RequestModel{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || len(records[0].Pods) != 4 {
+		t.Fatalf("expected one record with four pods, got %+v", records)
+	}
+	for _, pod := range records[0].Pods {
+		if pod.Name != "nginx-5d8-abcde" {
+			continue
+		}
+		if pod.Namespace != "default" || pod.UID != "uid-nginx-5d8-abcde" || pod.Node != "worker-1" ||
+			pod.WorkloadKind != "Deployment" || pod.WorkloadName != "nginx" || len(pod.Containers) != 2 {
+			t.Fatalf("unexpected identity of the deployment pod %+v", pod)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		podname string
+		req     models.GetPodMetricsV2RequestModel
+		pods    []string
+	}{
+		{
+			name:    "namespace",
+			podname: "%",
+			req:     models.GetPodMetricsV2RequestModel{Namespace: "payments"},
+			pods:    []string{"payments/db-0"},
+		},
+		{
+			name:    "deployment resolved from its replica set",
+			podname: "%",
+			req:     models.GetPodMetricsV2RequestModel{WorkloadKind: "Deployment", WorkloadName: "nginx"},
+			pods:    []string{"default/nginx-5d8-abcde", "default/nginx-5d8-fghij"},
+		},
+		{
+			name:    "workload kind",
+			podname: "%",
+			req:     models.GetPodMetricsV2RequestModel{WorkloadKind: "StatefulSet"},
+			pods:    []string{"payments/db-0"},
+		},
+		{
+			name:    "pod name in other namespace",
+			podname: "debug",
+			req:     models.GetPodMetricsV2RequestModel{Namespace: "payments"},
+			pods:    []string{},
+		},
+		{
+			name:    "pod name",
+			podname: "debug",
+			pods:    []string{"default/debug"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			records, err := GetPodMetricsV2(cluster, db, test.podname, &test.req, &start, &end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != 1 {
+				t.Fatalf("expected one record in the range, got %d", len(records))
+			}
+			pods := recordedPods(records)
+			if strings.Join(pods, ",") != strings.Join(test.pods, ",") {
+				t.Fatalf("expected pods %v, got %v", test.pods, pods)
+			}
+		})
+	}
+
+	wide := start.Add(-5 * time.Hour)
+	for _, timeRange := range [][2]*time.Time{{&wide, &end}, {&end, &start}} {
+		_, err = GetPodMetricsV2(cluster, db, "%", &models.GetPodMetricsV2RequestModel{}, timeRange[0], timeRange[1])
+		if !errors.Is(err, ErrInvalidRequest) {
+			t.Fatalf("expected invalid time range %v, got %v", timeRange, err)
+		}
+	}
+}
+
+func TestNodeMetrics(t *testing.T) {
+
+	db := newMetricsTestDB(t)
+	cluster := newMetricsTestCluster(t)
+	other := newMetricsTestCluster(t)
+	other.Name = "other"
+
+	start := time.Now().Add(-time.Minute)
+	for _, current := range []*Cluster{cluster, cluster, other} {
+		err := saveNodeMetricsToDB(current.Clientset, current.Metricsset, current.Name, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	end := time.Now().Add(time.Minute)
+
+	// most recent record of the cluster without time range
+	records, err := GetNodeMetrics(cluster, db, "%", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Cluster != "test" || len(records[0].Nodes) != 1 {
+		t.Fatalf("expected the last record of the cluster, got %+v", records)
+	}
+	node := records[0].Nodes[0]
+	if node.Name != "worker-1" || node.CPUUsage != 1250 || node.MemoryUsage != 3<<30 ||
+		node.CPUAllocatable != 3800 || node.MemoryAllocatable != 7<<30 {
+		t.Fatalf("unexpected node metrics %+v", node)
+	}
+
+	records, err = GetNodeMetrics(cluster, db, "worker-1", &start, &end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || len(records[0].Nodes) != 1 || len(records[1].Nodes) != 1 {
+		t.Fatalf("expected two records of worker-1, got %+v", records)
+	}
+
+	// records of the range are returned without nodes not matching the name
+	records, err = GetNodeMetrics(cluster, db, "worker-2", &start, &end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || len(records[0].Nodes) != 0 {
+		t.Fatalf("expected records without nodes, got %+v", records)
+	}
+
+	wide := start.Add(-5 * time.Hour)
+	_, err = GetNodeMetrics(cluster, db, "%", &wide, &end)
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("expected time range to be too wide, got %v", err)
+	}
+}
+
+func TestAggregateWorkloadMetrics(t *testing.T) {
+
+	start := time.Date(2024, 8, 24, 20, 0, 0, 0, time.UTC)
+	sample := func(record uint, offset time.Duration, namespace string, kind string, name string, cpu int64) podUsageSample {
+		return podUsageSample{
+			RecordID: record, CreatedAt: start.Add(offset),
+			Namespace: namespace, WorkloadKind: kind, WorkloadName: name,
+			CPU: cpu, Memory: cpu * 1024,
+		}
+	}
+
+	samples := []podUsageSample{
+		// two collections of the first interval, the deployment scales up in the second
+		sample(1, 0, "default", "Deployment", "nginx", 100),
+		sample(1, 0, "payments", "StatefulSet", "db", 400),
+		sample(2, 5*time.Second, "default", "Deployment", "nginx", 120),
+		sample(2, 5*time.Second, "default", "Deployment", "nginx", 80),
+		sample(2, 5*time.Second, "payments", "StatefulSet", "db", 300),
+		// pods without workload and records without namespace
+		sample(2, 5*time.Second, "default", "", "", 10),
+		sample(2, 5*time.Second, "", "", "", 1000),
+		// second interval
+		sample(3, 90*time.Second, "default", "Deployment", "nginx", 50),
+	}
+
+	workloads := aggregateWorkloadMetrics(samples, "workload", start, time.Minute)
+	if len(workloads) != 2 || workloads[0].Name != "nginx" || workloads[1].Name != "db" {
+		t.Fatalf("expected nginx and db, got %+v", workloads)
+	}
+
+	nginx := workloads[0].Intervals
+	expected := []models.WorkloadMetricsIntervalModel{
+		{
+			Start: "2024-08-24T20:00:00Z", Samples: 2, Pods: 2,
+			CPUSum: 150, CPUAvg: 100, CPUMax: 120,
+			MemorySum: 150 * 1024, MemoryAvg: 100 * 1024, MemoryMax: 120 * 1024,
+		},
+		{
+			Start: "2024-08-24T20:01:00Z", Samples: 1, Pods: 1,
+			CPUSum: 50, CPUAvg: 50, CPUMax: 50,
+			MemorySum: 50 * 1024, MemoryAvg: 50 * 1024, MemoryMax: 50 * 1024,
+		},
+	}
+	if len(nginx) != len(expected) {
+		t.Fatalf("expected intervals %+v, got %+v", expected, nginx)
+	}
+	for i := range expected {
+		if nginx[i] != expected[i] {
+			t.Fatalf("expected interval %+v, got %+v", expected[i], nginx[i])
+		}
+	}
+
+	namespaces := aggregateWorkloadMetrics(samples, "namespace", start, time.Minute)
+	if len(namespaces) != 2 || namespaces[0].Namespace != "default" || namespaces[0].Kind != "" {
+		t.Fatalf("expected default and payments namespaces, got %+v", namespaces)
+	}
+	// pods without workload count in their namespace
+	if first := namespaces[0].Intervals[0]; first.Pods != 3 || first.CPUSum != 155 {
+		t.Fatalf("expected 3 pods using 155m in default, got %+v", first)
+	}
+}
+
+func TestWorkloadMetrics(t *testing.T) {
+
+	db := newMetricsTestDB(t)
+	cluster := newMetricsTestCluster(t)
+	other := newMetricsTestCluster(t)
+	other.Name = "other"
+
+	start := time.Now().Add(-time.Minute)
+	if err := savePodMetricsToDB(cluster, db); err != nil {
+		t.Fatal(err)
+	}
+	// the first nginx pod uses more CPU in the second collection
+	err := cluster.Metricsset.(*fakemetricsv.Clientset).Tracker().Update(
+		schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"},
+		&metricsapiv1beta1.PodMetrics{
+			ObjectMeta: metaapiv1.ObjectMeta{Namespace: "default", Name: "nginx-5d8-abcde"},
+			Containers: []metricsapiv1beta1.ContainerMetrics{
+				testContainerMetrics("nginx", 280, 48), testContainerMetrics("sidecar", 20, 16),
+			},
+		},
+		"default",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, current := range []*Cluster{cluster, other} {
+		if err := savePodMetricsToDB(current, db); err != nil {
+			t.Fatal(err)
+		}
+	}
+	end := time.Now().Add(time.Minute)
+
+	// both collections fall in one interval, usage in millicores and bytes
+	type usage struct {
+		namespace, kind, name  string
+		samples, pods          int
+		cpuSum, cpuAvg, cpuMax int64
+		memorySum, memoryMax   int64
+	}
+	nginx := usage{"default", "Deployment", "nginx", 2, 2, 400, 200, 300, 192 << 20, 128 << 20}
+	db0 := usage{"payments", "StatefulSet", "db", 2, 1, 400, 400, 400, 1 << 30, 1 << 30}
+
+	tests := []struct {
+		name      string
+		req       models.GetWorkloadMetricsRequestModel
+		workloads []usage
+	}{
+		{
+			name:      "workloads",
+			req:       models.GetWorkloadMetricsRequestModel{Interval: "1h"},
+			workloads: []usage{nginx, db0},
+		},
+		{
+			name: "namespaces",
+			req:  models.GetWorkloadMetricsRequestModel{GroupBy: "namespace", Interval: "1h"},
+			workloads: []usage{
+				{"default", "", "", 2, 3, 410, 136, 300, 208 << 20, 128 << 20},
+				{"payments", "", "", 2, 1, 400, 400, 400, 1 << 30, 1 << 30},
+			},
+		},
+		{
+			name:      "namespace",
+			req:       models.GetWorkloadMetricsRequestModel{Namespace: "payments", Interval: "1h"},
+			workloads: []usage{db0},
+		},
+		{
+			name:      "workload",
+			req:       models.GetWorkloadMetricsRequestModel{WorkloadKind: "Deployment", WorkloadName: "nginx", Interval: "1h"},
+			workloads: []usage{nginx},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			metrics, err := GetWorkloadMetrics(cluster, db, &test.req, start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(metrics.Workloads) != len(test.workloads) {
+				t.Fatalf("expected %d workloads, got %+v", len(test.workloads), metrics.Workloads)
+			}
+			for i, expected := range test.workloads {
+				workload := metrics.Workloads[i]
+				if len(workload.Intervals) != 1 {
+					t.Fatalf("expected one interval of %s, got %+v", expected.name, workload.Intervals)
+				}
+				summary := workload.Intervals[0]
+				got := usage{
+					workload.Namespace, workload.Kind, workload.Name, summary.Samples, summary.Pods,
+					summary.CPUSum, summary.CPUAvg, summary.CPUMax, summary.MemorySum, summary.MemoryMax,
+				}
+				if got != expected {
+					t.Fatalf("expected %+v, got %+v", expected, got)
+				}
+			}
+		})
+	}
+
+	for _, interval := range []string{"1s", "five minutes"} {
+		req := &models.GetWorkloadMetricsRequestModel{Interval: interval}
+		_, err := GetWorkloadMetrics(cluster, db, req, start, end)
+		if !errors.Is(err, ErrInvalidRequest) {
+			t.Fatalf("expected interval %q to be rejected, got %v", interval, err)
+		}
+	}
+}
+
+func TestMigratePodMetrics(t *testing.T) {
+
+	db := newMetricsTestDB(t)
+	created := time.Date(2024, 8, 24, 20, 0, 0, 0, time.UTC)
+
+	pod := func(namespace string, name string) *coreapiv1.Pod {
+		controllerRef := true
+		return &coreapiv1.Pod{
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Namespace: namespace, Name: name, UID: types.UID("uid-" + name),
+				CreationTimestamp: metaapiv1.NewTime(created),
+				OwnerReferences: []metaapiv1.OwnerReference{{
+					Kind: "StatefulSet", Name: "web", Controller: &controllerRef,
+				}},
+			},
+			Spec: coreapiv1.PodSpec{NodeName: "worker-1"},
+		}
+	}
+	clientset := fakekubernetes.NewSimpleClientset(
+		pod("payments", "web-0"), pod("default", "dup"), pod("payments", "dup"),
+	)
+	podLists := 0
+	clientset.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		podLists++
+		return false, nil, nil
+	})
+	cluster := NewCluster("test", clientset, fakemetricsv.NewSimpleClientset(), nil)
+
+	// records stored by older versions only know the pod name
+	stored := func(cluster string, offset time.Duration, names ...string) {
+		record := models.DBClusterMetricsModel{Cluster: cluster}
+		record.CreatedAt = created.Add(offset)
+		for _, name := range names {
+			pod := models.DBPodMetricsModel{Name: name}
+			pod.CreatedAt = record.CreatedAt
+			record.Pods = append(record.Pods, pod)
+		}
+		if err := db.Create(&record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// web-0 of an earlier pod, then the current one, dup is ambiguous
+	// and gone doesn't exist anymore
+	stored("test", -time.Hour, "web-0")
+	stored("test", time.Hour, "web-0", "dup", "gone")
+	stored("other", time.Hour, "web-0")
+	// record of the version before clusters, the added column is null
+	stored("", time.Hour, "web-0")
+	if err := db.Exec("UPDATE db_cluster_metrics_models SET cluster = NULL WHERE cluster = ''").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// the migration waits until the record is assigned to a cluster
+	if err := MigratePodMetrics(cluster, db); err == nil {
+		t.Fatal("expected migration to fail with unassigned records")
+	}
+	if err := database.AssignDefaultCluster(db, "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := MigratePodMetrics(cluster, db); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if podLists != 1 {
+		t.Fatalf("expected pods to be listed once, got %d", podLists)
+	}
+
+	var records []models.DBPodMetricsModel
+	if err := db.Order("id").Find(&records).Error; err != nil {
+		t.Fatal(err)
+	}
+	expected := []models.DBPodMetricsModel{
+		{Name: "web-0"},
+		{Name: "web-0", Namespace: "payments", Node: "worker-1", WorkloadKind: "StatefulSet", WorkloadName: "web"},
+		{Name: "dup"},
+		{Name: "gone"},
+		{Name: "web-0"},
+		{Name: "web-0", Namespace: "payments", Node: "worker-1", WorkloadKind: "StatefulSet", WorkloadName: "web"},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+	// UID of the migrated record is unknown, it may come from an earlier pod
+	for i, record := range records {
+		if record.Name != expected[i].Name || record.Namespace != expected[i].Namespace ||
+			record.UID != "" || record.Node != expected[i].Node ||
+			record.WorkloadKind != expected[i].WorkloadKind || record.WorkloadName != expected[i].WorkloadName {
+			t.Fatalf("expected record %d to be %+v, got %+v", i, expected[i], record)
+		}
+	}
+}
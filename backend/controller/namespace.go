@@ -0,0 +1,430 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// parse quantities given by the user, ex. {"cpu": "100m"}
+func parseResourceList(quantities map[string]string) (coreapiv1.ResourceList, error) {
+
+	resources := coreapiv1.ResourceList{}
+	for name, value := range quantities {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%w: invalid quantity %q of %s", ErrInvalidRequest, value, name,
+			)
+		}
+		resources[coreapiv1.ResourceName(name)] = quantity
+	}
+
+	return resources, nil
+}
+
+func formatResourceList(resources coreapiv1.ResourceList) map[string]string {
+
+	quantities := map[string]string{}
+	for name, quantity := range resources {
+		quantities[string(name)] = quantity.String()
+	}
+
+	return quantities
+}
+
+// pair used and hard values of the quota, sorted by resource name
+func quotaUsage(quota *coreapiv1.ResourceQuota) []models.ResourceQuotaUsageModel {
+
+	usage := []models.ResourceQuotaUsageModel{}
+	for name, hard := range quota.Status.Hard {
+		used := quota.Status.Used[name]
+		usage = append(usage, models.ResourceQuotaUsageModel{
+			Resource: string(name),
+			Used:     used.String(),
+			Hard:     hard.String(),
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].Resource < usage[j].Resource
+	})
+
+	return usage
+}
+
+func ListNamespacesV2(
+	clientset kubernetes.Interface,
+	req *models.ListNamespacesV2RequestModel,
+) (models.ListNamespacesV2ResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListNamespacesV2ResponseModel{}, err
+	}
+	namespaceList, err := clientset.CoreV1().Namespaces().List(context.TODO(), options)
+	if err != nil {
+		return models.ListNamespacesV2ResponseModel{}, listError(err)
+	}
+	err = sortObjects(
+		namespaceList.Items, &req.ListOptionsModel,
+		func(namespace *coreapiv1.Namespace) string {
+			return string(namespace.Status.Phase)
+		},
+		nil,
+	)
+	if err != nil {
+		return models.ListNamespacesV2ResponseModel{}, err
+	}
+
+	// quotas of all namespaces are fetched at once instead of per namespace,
+	// users who can't list them get the namespaces without quotas
+	quotaList, err := clientset.CoreV1().ResourceQuotas("").List(
+		context.TODO(), metaapiv1.ListOptions{},
+	)
+	if apierrors.IsForbidden(err) {
+		quotaList, err = &coreapiv1.ResourceQuotaList{}, nil
+	}
+	if err != nil {
+		return models.ListNamespacesV2ResponseModel{}, listError(err)
+	}
+	quotas := map[string][]models.ResourceQuotaUsageModel{}
+	for i := range quotaList.Items {
+		quota := &quotaList.Items[i]
+		quotas[quota.Namespace] = append(quotas[quota.Namespace], quotaUsage(quota)...)
+	}
+
+	resp := models.ListNamespacesV2ResponseModel{}
+	resp.Namespaces = []models.ListNamespacesV2ResponseModelNamespace{}
+	for _, nsdata := range namespaceList.Items {
+		currentNamespace := models.ListNamespacesV2ResponseModelNamespace{}
+		currentNamespace.Name = nsdata.Name
+		currentNamespace.Status = string(nsdata.Status.Phase)
+		currentNamespace.Labels = nsdata.Labels
+		currentNamespace.Annotations = nsdata.Annotations
+		currentNamespace.CreationTime =
+			nsdata.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+		currentNamespace.Age =
+			duration.HumanDuration(time.Since(nsdata.CreationTimestamp.Time))
+		currentNamespace.Quota = quotas[nsdata.Name]
+		if currentNamespace.Quota == nil {
+			currentNamespace.Quota = []models.ResourceQuotaUsageModel{}
+		}
+		resp.Namespaces = append(resp.Namespaces, currentNamespace)
+	}
+	resp.Pagination = pagination(namespaceList.ListMeta, len(resp.Namespaces))
+
+	return resp, nil
+
+}
+
+func CreateNamespace(
+	clientset kubernetes.Interface,
+	req *models.CreateNamespaceRequestModel,
+) error {
+
+	namespace := &coreapiv1.Namespace{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name:        req.Name,
+			Labels:      req.Labels,
+			Annotations: req.Annotations,
+		},
+	}
+
+	_, err := clientset.CoreV1().Namespaces().Create(
+		context.TODO(), namespace, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdateNamespace(
+	clientset kubernetes.Interface,
+	req *models.UpdateNamespaceRequestModel,
+) error {
+
+	namespace, err := clientset.CoreV1().Namespaces().Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	// maps left out of the request keep the current values, empty ones clear them
+	if req.Labels != nil {
+		namespace.Labels = req.Labels
+	}
+	if req.Annotations != nil {
+		namespace.Annotations = req.Annotations
+	}
+
+	_, err = clientset.CoreV1().Namespaces().Update(
+		context.TODO(), namespace, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+// the confirmation token has to be checked by the caller
+func DeleteNamespace(clientset kubernetes.Interface, name string) error {
+
+	err := clientset.CoreV1().Namespaces().Delete(
+		context.TODO(), name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
+
+func ListResourceQuotas(
+	clientset kubernetes.Interface,
+	req *models.ListResourceQuotasRequestModel,
+) (models.ListResourceQuotasResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListResourceQuotasResponseModel{}, err
+	}
+	quotaList, err := clientset.CoreV1().ResourceQuotas(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListResourceQuotasResponseModel{}, listError(err)
+	}
+	err = sortObjects(quotaList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListResourceQuotasResponseModel{}, err
+	}
+
+	resp := models.ListResourceQuotasResponseModel{}
+	resp.ResourceQuotas = []models.ListResourceQuotasResponseModelQuota{}
+	for i := range quotaList.Items {
+		quota := &quotaList.Items[i]
+		resp.ResourceQuotas = append(
+			resp.ResourceQuotas,
+			models.ListResourceQuotasResponseModelQuota{
+				Name:      quota.Name,
+				Namespace: quota.Namespace,
+				Usage:     quotaUsage(quota),
+			},
+		)
+	}
+	resp.Pagination = pagination(quotaList.ListMeta, len(resp.ResourceQuotas))
+
+	return resp, nil
+
+}
+
+func CreateResourceQuota(
+	clientset kubernetes.Interface,
+	req *models.ResourceQuotaModel,
+) error {
+
+	hard, err := parseResourceList(req.Hard)
+	if err != nil {
+		return err
+	}
+
+	quota := &coreapiv1.ResourceQuota{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+		Spec: coreapiv1.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}
+
+	_, err = clientset.CoreV1().ResourceQuotas(req.Namespace).Create(
+		context.TODO(), quota, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdateResourceQuota(
+	clientset kubernetes.Interface,
+	req *models.ResourceQuotaModel,
+) error {
+
+	hard, err := parseResourceList(req.Hard)
+	if err != nil {
+		return err
+	}
+
+	quota, err := clientset.CoreV1().ResourceQuotas(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	quota.Spec.Hard = hard
+
+	_, err = clientset.CoreV1().ResourceQuotas(req.Namespace).Update(
+		context.TODO(), quota, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+func DeleteResourceQuota(
+	clientset kubernetes.Interface,
+	req *models.DeleteResourceQuotaRequestModel,
+) error {
+
+	err := clientset.CoreV1().ResourceQuotas(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
+
+func buildLimitRangeItems(
+	limits []models.LimitRangeItemModel,
+) ([]coreapiv1.LimitRangeItem, error) {
+
+	items := []coreapiv1.LimitRangeItem{}
+	for _, limit := range limits {
+		item := coreapiv1.LimitRangeItem{
+			Type: coreapiv1.LimitType(limit.Type),
+		}
+
+		var err error
+		if item.Max, err = parseResourceList(limit.Max); err != nil {
+			return nil, err
+		}
+		if item.Min, err = parseResourceList(limit.Min); err != nil {
+			return nil, err
+		}
+		if item.Default, err = parseResourceList(limit.Default); err != nil {
+			return nil, err
+		}
+		if item.DefaultRequest, err = parseResourceList(limit.DefaultRequest); err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func ListLimitRanges(
+	clientset kubernetes.Interface,
+	req *models.ListLimitRangesRequestModel,
+) (models.ListLimitRangesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListLimitRangesResponseModel{}, err
+	}
+	limitRangeList, err := clientset.CoreV1().LimitRanges(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListLimitRangesResponseModel{}, listError(err)
+	}
+	err = sortObjects(limitRangeList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListLimitRangesResponseModel{}, err
+	}
+
+	resp := models.ListLimitRangesResponseModel{}
+	resp.LimitRanges = []models.LimitRangeModel{}
+	for _, lrdata := range limitRangeList.Items {
+		currentLimitRange := models.LimitRangeModel{
+			Name:      lrdata.Name,
+			Namespace: lrdata.Namespace,
+			Limits:    []models.LimitRangeItemModel{},
+		}
+		for _, item := range lrdata.Spec.Limits {
+			currentLimitRange.Limits = append(
+				currentLimitRange.Limits,
+				models.LimitRangeItemModel{
+					Type:           string(item.Type),
+					Max:            formatResourceList(item.Max),
+					Min:            formatResourceList(item.Min),
+					Default:        formatResourceList(item.Default),
+					DefaultRequest: formatResourceList(item.DefaultRequest),
+				},
+			)
+		}
+		resp.LimitRanges = append(resp.LimitRanges, currentLimitRange)
+	}
+	resp.Pagination = pagination(limitRangeList.ListMeta, len(resp.LimitRanges))
+
+	return resp, nil
+
+}
+
+func CreateLimitRange(
+	clientset kubernetes.Interface,
+	req *models.LimitRangeModel,
+) error {
+
+	items, err := buildLimitRangeItems(req.Limits)
+	if err != nil {
+		return err
+	}
+
+	limitRange := &coreapiv1.LimitRange{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+		Spec: coreapiv1.LimitRangeSpec{
+			Limits: items,
+		},
+	}
+
+	_, err = clientset.CoreV1().LimitRanges(req.Namespace).Create(
+		context.TODO(), limitRange, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdateLimitRange(
+	clientset kubernetes.Interface,
+	req *models.LimitRangeModel,
+) error {
+
+	items, err := buildLimitRangeItems(req.Limits)
+	if err != nil {
+		return err
+	}
+
+	limitRange, err := clientset.CoreV1().LimitRanges(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	limitRange.Spec.Limits = items
+
+	_, err = clientset.CoreV1().LimitRanges(req.Namespace).Update(
+		context.TODO(), limitRange, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+func DeleteLimitRange(
+	clientset kubernetes.Interface,
+	req *models.DeleteLimitRangeRequestModel,
+) error {
+
+	err := clientset.CoreV1().LimitRanges(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"testing"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func TestListNamespacesWithoutQuotaAccess(t *testing.T) {
+
+	clientset := fakekubernetes.NewSimpleClientset(
+		&coreapiv1.Namespace{ObjectMeta: metaapiv1.ObjectMeta{Name: "default"}},
+		&coreapiv1.ResourceQuota{ObjectMeta: metaapiv1.ObjectMeta{Name: "compute", Namespace: "default"}},
+	)
+	clientset.PrependReactor("list", "resourcequotas", forbidList("", "resourcequotas"))
+
+	namespaces, err := ListNamespacesV2(clientset, &models.ListNamespacesV2RequestModel{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(namespaces.Namespaces) != 1 || len(namespaces.Namespaces[0].Quota) != 0 {
+		t.Fatalf("expected namespace without quotas, got %+v", namespaces.Namespaces)
+	}
+}
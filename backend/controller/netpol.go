@@ -0,0 +1,361 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	networkingapiv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// pod on one side of the evaluated connection with the labels of its namespace
+type policyEndpoint struct {
+	pod             *coreapiv1.Pod
+	namespaceLabels map[string]string
+}
+
+// policy types the policy applies to, without explicit types it's always
+// ingress and also egress when it has egress rules
+func effectivePolicyTypes(policy *networkingapiv1.NetworkPolicy) []networkingapiv1.PolicyType {
+
+	if len(policy.Spec.PolicyTypes) > 0 {
+		return policy.Spec.PolicyTypes
+	}
+
+	types := []networkingapiv1.PolicyType{networkingapiv1.PolicyTypeIngress}
+	if len(policy.Spec.Egress) > 0 {
+		types = append(types, networkingapiv1.PolicyTypeEgress)
+	}
+
+	return types
+}
+
+func hasPolicyType(policy *networkingapiv1.NetworkPolicy, policyType networkingapiv1.PolicyType) bool {
+
+	for _, effective := range effectivePolicyTypes(policy) {
+		if effective == policyType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nil selector selects nothing, empty one everything
+func selectorMatches(selector *metaapiv1.LabelSelector, objectLabels map[string]string) bool {
+
+	if selector == nil {
+		return false
+	}
+	parsed, err := metaapiv1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+
+	return parsed.Matches(labels.Set(objectLabels))
+}
+
+func policySelectsPod(policy *networkingapiv1.NetworkPolicy, pod *coreapiv1.Pod) bool {
+	return policy.Namespace == pod.Namespace && selectorMatches(&policy.Spec.PodSelector, pod.Labels)
+}
+
+func ipBlockMatches(block *networkingapiv1.IPBlock, pod *coreapiv1.Pod) bool {
+
+	ip := net.ParseIP(pod.Status.PodIP)
+	if ip == nil {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(block.CIDR)
+	if err != nil || !cidr.Contains(ip) {
+		return false
+	}
+	for _, except := range block.Except {
+		_, excluded, err := net.ParseCIDR(except)
+		if err == nil && excluded.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// whether the peer of the rule of the policy selects the endpoint, pod selector
+// alone selects pods in the namespace of the policy, with namespace selector
+// the pods in selected namespaces
+func peerMatches(
+	policy *networkingapiv1.NetworkPolicy,
+	peer *networkingapiv1.NetworkPolicyPeer,
+	endpoint policyEndpoint,
+) bool {
+
+	if peer.IPBlock != nil {
+		return ipBlockMatches(peer.IPBlock, endpoint.pod)
+	}
+
+	if peer.NamespaceSelector == nil {
+		return endpoint.pod.Namespace == policy.Namespace &&
+			selectorMatches(peer.PodSelector, endpoint.pod.Labels)
+	}
+	if !selectorMatches(peer.NamespaceSelector, endpoint.namespaceLabels) {
+		return false
+	}
+
+	return peer.PodSelector == nil || selectorMatches(peer.PodSelector, endpoint.pod.Labels)
+}
+
+// rule without peers allows everyone
+func peersMatch(
+	policy *networkingapiv1.NetworkPolicy,
+	peers []networkingapiv1.NetworkPolicyPeer,
+	endpoint policyEndpoint,
+) bool {
+
+	if len(peers) == 0 {
+		return true
+	}
+	for i := range peers {
+		if peerMatches(policy, &peers[i], endpoint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// number of the named container port of the pod, 0 when it has no such port
+func namedPort(pod *coreapiv1.Pod, name string, protocol coreapiv1.Protocol) int32 {
+
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			portProtocol := port.Protocol
+			if portProtocol == "" {
+				portProtocol = coreapiv1.ProtocolTCP
+			}
+			if port.Name == name && portProtocol == protocol {
+				return port.ContainerPort
+			}
+		}
+	}
+
+	return 0
+}
+
+// rule without ports allows all of them, named ports are looked up
+// in the containers of the destination pod
+func portsMatch(
+	ports []networkingapiv1.NetworkPolicyPort,
+	destination *coreapiv1.Pod,
+	port int32,
+	protocol coreapiv1.Protocol,
+) bool {
+
+	if len(ports) == 0 {
+		return true
+	}
+	for _, policyPort := range ports {
+		policyProtocol := coreapiv1.ProtocolTCP
+		if policyPort.Protocol != nil {
+			policyProtocol = *policyPort.Protocol
+		}
+		if policyProtocol != protocol {
+			continue
+		}
+		if policyPort.Port == nil {
+			return true
+		}
+
+		number := policyPort.Port.IntVal
+		if policyPort.Port.Type == intstr.String {
+			number = namedPort(destination, policyPort.Port.StrVal, protocol)
+			if number == 0 {
+				continue
+			}
+		}
+		endPort := number
+		if policyPort.EndPort != nil {
+			endPort = *policyPort.EndPort
+		}
+		if port >= number && port <= endPort {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verdict of one direction, the pod is isolated when a policy of the direction
+// selects it and then the connection needs a rule of any such policy allowing it
+func evaluatePolicyDirection(
+	policies []networkingapiv1.NetworkPolicy,
+	policyType networkingapiv1.PolicyType,
+	pod policyEndpoint,
+	peer policyEndpoint,
+	destination *coreapiv1.Pod,
+	port int32,
+	protocol coreapiv1.Protocol,
+) models.NetworkPolicyVerdictModel {
+
+	verdict := models.NetworkPolicyVerdictModel{Policies: []string{}, AllowedBy: []string{}}
+
+	for i := range policies {
+		policy := &policies[i]
+		if !hasPolicyType(policy, policyType) || !policySelectsPod(policy, pod.pod) {
+			continue
+		}
+		verdict.Policies = append(verdict.Policies, policy.Name)
+
+		allowed := false
+		if policyType == networkingapiv1.PolicyTypeIngress {
+			for _, rule := range policy.Spec.Ingress {
+				if peersMatch(policy, rule.From, peer) && portsMatch(rule.Ports, destination, port, protocol) {
+					allowed = true
+					break
+				}
+			}
+		} else {
+			for _, rule := range policy.Spec.Egress {
+				if peersMatch(policy, rule.To, peer) && portsMatch(rule.Ports, destination, port, protocol) {
+					allowed = true
+					break
+				}
+			}
+		}
+		if allowed {
+			verdict.AllowedBy = append(verdict.AllowedBy, policy.Name)
+		}
+	}
+
+	direction := strings.ToLower(string(policyType))
+	verdict.Isolated = len(verdict.Policies) > 0
+	verdict.Allowed = !verdict.Isolated || len(verdict.AllowedBy) > 0
+	switch {
+	case !verdict.Isolated:
+		verdict.Reason = fmt.Sprintf("no %s policy selects pod %s, everything is allowed", direction, pod.pod.Name)
+	case verdict.Allowed:
+		verdict.Reason = fmt.Sprintf("allowed by %s", strings.Join(verdict.AllowedBy, ", "))
+	default:
+		verdict.Reason = fmt.Sprintf(
+			"pod %s is isolated for %s by %s and no rule allows the connection",
+			pod.pod.Name, direction, strings.Join(verdict.Policies, ", "),
+		)
+	}
+
+	return verdict
+}
+
+// whether the source pod can connect to the port (number or name of the
+// destination container port) of the destination pod according to the
+// network policies, both egress of the source and ingress of the
+// destination have to allow it
+func evaluateNetworkPolicies(
+	policies []networkingapiv1.NetworkPolicy,
+	source policyEndpoint,
+	destination policyEndpoint,
+	port string,
+	protocol coreapiv1.Protocol,
+) (models.EvaluateNetworkPolicyResponseModel, error) {
+
+	if protocol == "" {
+		protocol = coreapiv1.ProtocolTCP
+	}
+
+	parsed := intstr.Parse(port)
+	number := parsed.IntVal
+	if parsed.Type == intstr.String {
+		number = namedPort(destination.pod, parsed.StrVal, protocol)
+		if number == 0 {
+			return models.EvaluateNetworkPolicyResponseModel{}, fmt.Errorf(
+				"%w: pod %s has no %s port named %s",
+				ErrInvalidRequest, destination.pod.Name, protocol, parsed.StrVal,
+			)
+		}
+	}
+	if number < 1 || number > 65535 {
+		return models.EvaluateNetworkPolicyResponseModel{}, fmt.Errorf(
+			"%w: invalid port %s", ErrInvalidRequest, port,
+		)
+	}
+
+	resp := models.EvaluateNetworkPolicyResponseModel{
+		Port:     number,
+		Protocol: string(protocol),
+		Egress: evaluatePolicyDirection(
+			policies, networkingapiv1.PolicyTypeEgress,
+			source, destination, destination.pod, number, protocol,
+		),
+		Ingress: evaluatePolicyDirection(
+			policies, networkingapiv1.PolicyTypeIngress,
+			destination, source, destination.pod, number, protocol,
+		),
+	}
+	resp.Allowed = resp.Egress.Allowed && resp.Ingress.Allowed
+
+	return resp, nil
+}
+
+func getPolicyEndpoint(
+	clientset kubernetes.Interface, namespace string, name string,
+) (policyEndpoint, error) {
+
+	pod, err := clientset.CoreV1().Pods(namespace).Get(context.TODO(), name, metaapiv1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return policyEndpoint{}, fmt.Errorf(
+			"%w: pod %q not found in namespace %q", ErrInvalidRequest, name, namespace,
+		)
+	}
+	if err != nil {
+		return policyEndpoint{}, err
+	}
+	ns, err := clientset.CoreV1().Namespaces().Get(context.TODO(), namespace, metaapiv1.GetOptions{})
+	if err != nil {
+		return policyEndpoint{}, err
+	}
+
+	return policyEndpoint{pod: pod, namespaceLabels: ns.Labels}, nil
+}
+
+// evaluate the network policies of the namespaces of both pods, policies of
+// other namespaces never select them
+func EvaluateNetworkPolicy(
+	clientset kubernetes.Interface,
+	req *models.EvaluateNetworkPolicyRequestModel,
+) (models.EvaluateNetworkPolicyResponseModel, error) {
+
+	source, err := getPolicyEndpoint(clientset, req.SourceNamespace, req.SourcePod)
+	if err != nil {
+		return models.EvaluateNetworkPolicyResponseModel{}, err
+	}
+	destination, err := getPolicyEndpoint(clientset, req.DestinationNamespace, req.DestinationPod)
+	if err != nil {
+		return models.EvaluateNetworkPolicyResponseModel{}, err
+	}
+
+	namespaces := []string{req.SourceNamespace}
+	if req.DestinationNamespace != req.SourceNamespace {
+		namespaces = append(namespaces, req.DestinationNamespace)
+	}
+
+	policies := []networkingapiv1.NetworkPolicy{}
+	for _, namespace := range namespaces {
+		policyList, err := clientset.NetworkingV1().NetworkPolicies(namespace).List(
+			context.TODO(), metaapiv1.ListOptions{},
+		)
+		if err != nil {
+			return models.EvaluateNetworkPolicyResponseModel{}, err
+		}
+		policies = append(policies, policyList.Items...)
+	}
+
+	return evaluateNetworkPolicies(
+		policies, source, destination, req.Port, coreapiv1.Protocol(req.Protocol),
+	)
+}
@@ -0,0 +1,269 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	networkingapiv1 "k8s.io/api/networking/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func testEndpoint(
+	namespace string, name string, podLabels map[string]string, ip string,
+	namespaceLabels map[string]string, ports ...coreapiv1.ContainerPort,
+) policyEndpoint {
+
+	return policyEndpoint{
+		pod: &coreapiv1.Pod{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: name, Namespace: namespace, Labels: podLabels},
+			Spec: coreapiv1.PodSpec{
+				Containers: []coreapiv1.Container{{Name: name, Ports: ports}},
+			},
+			Status: coreapiv1.PodStatus{PodIP: ip},
+		},
+		namespaceLabels: namespaceLabels,
+	}
+}
+
+func testPolicy(
+	namespace string, name string, podLabels map[string]string,
+	policyTypes []networkingapiv1.PolicyType,
+	ingress []networkingapiv1.NetworkPolicyIngressRule,
+	egress []networkingapiv1.NetworkPolicyEgressRule,
+) networkingapiv1.NetworkPolicy {
+
+	return networkingapiv1.NetworkPolicy{
+		ObjectMeta: metaapiv1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: networkingapiv1.NetworkPolicySpec{
+			PodSelector: metaapiv1.LabelSelector{MatchLabels: podLabels},
+			PolicyTypes: policyTypes,
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+}
+
+func podPeer(podLabels map[string]string, namespaceLabels map[string]string) networkingapiv1.NetworkPolicyPeer {
+
+	peer := networkingapiv1.NetworkPolicyPeer{}
+	if podLabels != nil {
+		peer.PodSelector = &metaapiv1.LabelSelector{MatchLabels: podLabels}
+	}
+	if namespaceLabels != nil {
+		peer.NamespaceSelector = &metaapiv1.LabelSelector{MatchLabels: namespaceLabels}
+	}
+
+	return peer
+}
+
+func policyPort(protocol coreapiv1.Protocol, port string, endPort int32) networkingapiv1.NetworkPolicyPort {
+
+	value := intstr.Parse(port)
+	policyPort := networkingapiv1.NetworkPolicyPort{Protocol: &protocol, Port: &value}
+	if endPort != 0 {
+		policyPort.EndPort = &endPort
+	}
+
+	return policyPort
+}
+
+var (
+	ingressOnly = []networkingapiv1.PolicyType{networkingapiv1.PolicyTypeIngress}
+	egressOnly  = []networkingapiv1.PolicyType{networkingapiv1.PolicyTypeEgress}
+	selectAll   = map[string]string{}
+)
+
+func TestEvaluateNetworkPolicies(t *testing.T) {
+
+	frontend := map[string]string{"team": "frontend"}
+	backend := map[string]string{"team": "backend"}
+	httpPort := coreapiv1.ContainerPort{Name: "http", ContainerPort: 8080}
+
+	web := testEndpoint("frontend", "web", map[string]string{"app": "web"}, "10.2.0.5", frontend)
+	admin := testEndpoint("frontend", "admin", map[string]string{"app": "admin"}, "10.1.0.5", frontend)
+	api := testEndpoint("backend", "api", map[string]string{"app": "api"}, "10.3.0.7", backend, httpPort)
+	worker := testEndpoint("backend", "worker", map[string]string{"app": "worker"}, "10.3.0.8", backend)
+	db := testEndpoint("backend", "db", map[string]string{"app": "db"}, "10.3.0.9", backend,
+		coreapiv1.ContainerPort{Name: "postgres", ContainerPort: 5432})
+
+	denyAll := testPolicy("backend", "deny-all", selectAll, ingressOnly, nil, nil)
+	allowWeb := testPolicy("backend", "allow-web", map[string]string{"app": "api"}, ingressOnly,
+		[]networkingapiv1.NetworkPolicyIngressRule{{
+			From:  []networkingapiv1.NetworkPolicyPeer{podPeer(map[string]string{"app": "web"}, frontend)},
+			Ports: []networkingapiv1.NetworkPolicyPort{policyPort(coreapiv1.ProtocolTCP, "http", 0)},
+		}}, nil)
+	allowSameNamespace := testPolicy("backend", "allow-same-namespace", selectAll, ingressOnly,
+		[]networkingapiv1.NetworkPolicyIngressRule{{
+			From: []networkingapiv1.NetworkPolicyPeer{podPeer(selectAll, nil)},
+		}}, nil)
+	allowRange := testPolicy("backend", "allow-range", map[string]string{"app": "api"}, ingressOnly,
+		[]networkingapiv1.NetworkPolicyIngressRule{{
+			Ports: []networkingapiv1.NetworkPolicyPort{policyPort(coreapiv1.ProtocolTCP, "8000", 8090)},
+		}}, nil)
+	allowIPs := testPolicy("backend", "allow-ips", map[string]string{"app": "api"}, ingressOnly,
+		[]networkingapiv1.NetworkPolicyIngressRule{{
+			From: []networkingapiv1.NetworkPolicyPeer{{IPBlock: &networkingapiv1.IPBlock{
+				CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"},
+			}}},
+		}}, nil)
+	webToDB := testPolicy("frontend", "web-to-db", map[string]string{"app": "web"}, egressOnly, nil,
+		[]networkingapiv1.NetworkPolicyEgressRule{{
+			To:    []networkingapiv1.NetworkPolicyPeer{podPeer(map[string]string{"app": "db"}, selectAll)},
+			Ports: []networkingapiv1.NetworkPolicyPort{policyPort(coreapiv1.ProtocolTCP, "postgres", 0)},
+		}})
+	// without policy types egress rules make the policy isolate egress too
+	implicitEgress := testPolicy("backend", "implicit-egress", map[string]string{"app": "worker"}, nil, nil,
+		[]networkingapiv1.NetworkPolicyEgressRule{{
+			Ports: []networkingapiv1.NetworkPolicyPort{policyPort(coreapiv1.ProtocolUDP, "53", 0)},
+		}})
+
+	tests := []struct {
+		name        string
+		policies    []networkingapiv1.NetworkPolicy
+		source      policyEndpoint
+		destination policyEndpoint
+		port        string
+		protocol    coreapiv1.Protocol
+		allowed     bool
+		egress      bool
+		ingress     bool
+		allowedBy   []string
+	}{
+		{
+			name: "no policies", source: web, destination: api, port: "8080",
+			allowed: true, egress: true, ingress: true,
+		},
+		{
+			name: "default deny", policies: []networkingapiv1.NetworkPolicy{denyAll},
+			source: web, destination: api, port: "8080",
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "allowed pod from selected namespace on named port",
+			policies: []networkingapiv1.NetworkPolicy{denyAll, allowWeb},
+			source:   web, destination: api, port: "8080",
+			allowed: true, egress: true, ingress: true, allowedBy: []string{"allow-web"},
+		},
+		{
+			name:     "port given by name",
+			policies: []networkingapiv1.NetworkPolicy{denyAll, allowWeb},
+			source:   web, destination: api, port: "http",
+			allowed: true, egress: true, ingress: true, allowedBy: []string{"allow-web"},
+		},
+		{
+			name:     "other port",
+			policies: []networkingapiv1.NetworkPolicy{denyAll, allowWeb},
+			source:   web, destination: api, port: "9090",
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "other protocol",
+			policies: []networkingapiv1.NetworkPolicy{denyAll, allowWeb},
+			source:   web, destination: api, port: "8080", protocol: coreapiv1.ProtocolUDP,
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "pod not matching peer",
+			policies: []networkingapiv1.NetworkPolicy{denyAll, allowWeb},
+			source:   admin, destination: api, port: "8080",
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "pod selector alone selects only namespace of the policy",
+			policies: []networkingapiv1.NetworkPolicy{allowSameNamespace},
+			source:   web, destination: worker, port: "8080",
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "pod selector alone in the same namespace",
+			policies: []networkingapiv1.NetworkPolicy{allowSameNamespace},
+			source:   api, destination: worker, port: "8080",
+			allowed: true, egress: true, ingress: true, allowedBy: []string{"allow-same-namespace"},
+		},
+		{
+			name:     "port range",
+			policies: []networkingapiv1.NetworkPolicy{allowRange},
+			source:   web, destination: api, port: "8085",
+			allowed: true, egress: true, ingress: true, allowedBy: []string{"allow-range"},
+		},
+		{
+			name:     "outside port range",
+			policies: []networkingapiv1.NetworkPolicy{allowRange},
+			source:   web, destination: api, port: "8091",
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "ip block",
+			policies: []networkingapiv1.NetworkPolicy{allowIPs},
+			source:   web, destination: api, port: "8080",
+			allowed: true, egress: true, ingress: true, allowedBy: []string{"allow-ips"},
+		},
+		{
+			name:     "ip block exception",
+			policies: []networkingapiv1.NetworkPolicy{allowIPs},
+			source:   admin, destination: api, port: "8080",
+			allowed: false, egress: true, ingress: false,
+		},
+		{
+			name:     "egress to allowed pod",
+			policies: []networkingapiv1.NetworkPolicy{webToDB},
+			source:   web, destination: db, port: "5432",
+			allowed: true, egress: true, ingress: true,
+		},
+		{
+			name:     "egress to other pod",
+			policies: []networkingapiv1.NetworkPolicy{webToDB},
+			source:   web, destination: api, port: "8080",
+			allowed: false, egress: false, ingress: true,
+		},
+		{
+			name:     "implicit egress isolation",
+			policies: []networkingapiv1.NetworkPolicy{implicitEgress},
+			source:   worker, destination: db, port: "5432",
+			allowed: false, egress: false, ingress: true,
+		},
+		{
+			name:     "implicit egress allowed port",
+			policies: []networkingapiv1.NetworkPolicy{implicitEgress},
+			source:   worker, destination: db, port: "53", protocol: coreapiv1.ProtocolUDP,
+			allowed: true, egress: true, ingress: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verdict, err := evaluateNetworkPolicies(
+				test.policies, test.source, test.destination, test.port, test.protocol,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if verdict.Allowed != test.allowed || verdict.Egress.Allowed != test.egress ||
+				verdict.Ingress.Allowed != test.ingress {
+				t.Fatalf(
+					"expected allowed %v (egress %v, ingress %v), got %+v",
+					test.allowed, test.egress, test.ingress, verdict,
+				)
+			}
+			if test.allowedBy != nil &&
+				(len(verdict.Ingress.AllowedBy) != 1 || verdict.Ingress.AllowedBy[0] != test.allowedBy[0]) {
+				t.Fatalf("expected allowed by %v, got %v", test.allowedBy, verdict.Ingress.AllowedBy)
+			}
+		})
+	}
+}
+
+func TestEvaluateNetworkPoliciesInvalidPort(t *testing.T) {
+
+	web := testEndpoint("frontend", "web", nil, "", nil)
+	api := testEndpoint("backend", "api", nil, "", nil)
+
+	for _, port := range []string{"metrics", "0", "70000"} {
+		_, err := evaluateNetworkPolicies(nil, web, api, port, "")
+		if !errors.Is(err, ErrInvalidRequest) {
+			t.Fatalf("port %s: expected invalid request, got %v", port, err)
+		}
+	}
+}
@@ -0,0 +1,430 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	policyapiv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	// default time given to drain before it gives up
+	drainDefaultTimeout = 300 * time.Second
+	// how often eviction blocked by PodDisruptionBudget is retried
+	drainRetryInterval = 5 * time.Second
+	// how often we check if evicted pod is already gone
+	drainPollInterval = 2 * time.Second
+)
+
+// pods that finished don't use node resources anymore
+func isPodTerminated(pod *coreapiv1.Pod) bool {
+	return pod.Status.Phase == coreapiv1.PodSucceeded ||
+		pod.Status.Phase == coreapiv1.PodFailed
+}
+
+// sum of requests of all app containers of the pod
+func podRequests(pod *coreapiv1.Pod) (cpu resource.Quantity, memory resource.Quantity) {
+
+	for _, container := range pod.Spec.Containers {
+		cpu.Add(*container.Resources.Requests.Cpu())
+		memory.Add(*container.Resources.Requests.Memory())
+	}
+
+	return cpu, memory
+}
+
+func nodeStatus(node *coreapiv1.Node) string {
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != coreapiv1.NodeReady {
+			continue
+		}
+		switch condition.Status {
+		case coreapiv1.ConditionTrue:
+			return "Ready"
+		case coreapiv1.ConditionFalse:
+			return "NotReady"
+		}
+	}
+
+	return "Unknown"
+}
+
+// roles are stored as labels like node-role.kubernetes.io/control-plane=""
+func nodeRoles(node *coreapiv1.Node) []string {
+
+	roles := []string{}
+	for label := range node.Labels {
+		if role, found := strings.CutPrefix(label, "node-role.kubernetes.io/"); found {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+
+	return roles
+}
+
+// summarize node data, pods are only the ones scheduled on this node
+func summarizeNode(
+	node *coreapiv1.Node, pods []coreapiv1.Pod,
+) models.ListNodesResponseModelNode {
+
+	currentNode := models.ListNodesResponseModelNode{}
+	currentNode.Name = node.Name
+	currentNode.Status = nodeStatus(node)
+	currentNode.Unschedulable = node.Spec.Unschedulable
+	currentNode.Roles = nodeRoles(node)
+	currentNode.KubeletVersion = node.Status.NodeInfo.KubeletVersion
+	currentNode.CreationTime = node.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+
+	currentNode.Capacity = models.NodeResourcesModel{
+		CPU:    node.Status.Capacity.Cpu().String(),
+		Memory: node.Status.Capacity.Memory().String(),
+		Pods:   node.Status.Capacity.Pods().String(),
+	}
+	currentNode.Allocatable = models.NodeResourcesModel{
+		CPU:    node.Status.Allocatable.Cpu().String(),
+		Memory: node.Status.Allocatable.Memory().String(),
+		Pods:   node.Status.Allocatable.Pods().String(),
+	}
+
+	var requestedCPU, requestedMemory resource.Quantity
+	for i := range pods {
+		if isPodTerminated(&pods[i]) {
+			continue
+		}
+		cpu, memory := podRequests(&pods[i])
+		requestedCPU.Add(cpu)
+		requestedMemory.Add(memory)
+		currentNode.PodCount++
+	}
+	currentNode.Requested = models.NodeResourcesModel{
+		CPU:    requestedCPU.String(),
+		Memory: requestedMemory.String(),
+	}
+
+	currentNode.Taints = []models.NodeTaintModel{}
+	for _, taint := range node.Spec.Taints {
+		currentNode.Taints = append(currentNode.Taints, models.NodeTaintModel{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: string(taint.Effect),
+		})
+	}
+
+	currentNode.Conditions = []models.NodeConditionModel{}
+	for _, condition := range node.Status.Conditions {
+		currentNode.Conditions = append(currentNode.Conditions, models.NodeConditionModel{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time.UTC().
+				Format(time.RFC3339),
+		})
+	}
+
+	return currentNode
+}
+
+func listNodePods(
+	clientset kubernetes.Interface, nodeName string,
+) (*coreapiv1.PodList, error) {
+
+	return clientset.CoreV1().Pods("").List(
+		context.TODO(), metaapiv1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+		},
+	)
+}
+
+func ListNodes(
+	clientset kubernetes.Interface,
+	req *models.ListNodesRequestModel,
+) (models.ListNodesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListNodesResponseModel{}, err
+	}
+	nodes, err := clientset.CoreV1().Nodes().List(context.TODO(), options)
+	if err != nil {
+		return models.ListNodesResponseModel{}, listError(err)
+	}
+	err = sortObjects(nodes.Items, &req.ListOptionsModel, nodeStatus, nil)
+	if err != nil {
+		return models.ListNodesResponseModel{}, err
+	}
+
+	resp := models.ListNodesResponseModel{}
+	resp.Nodes = []models.ListNodesResponseModelNode{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		// only pods of the nodes on this page, not of the whole cluster
+		pods, err := listNodePods(clientset, node.Name)
+		if err != nil {
+			return models.ListNodesResponseModel{}, listError(err)
+		}
+		resp.Nodes = append(resp.Nodes, summarizeNode(node, pods.Items))
+	}
+	resp.Pagination = pagination(nodes.ListMeta, len(resp.Nodes))
+
+	return resp, nil
+
+}
+
+func GetNode(
+	clientset kubernetes.Interface,
+	req *models.GetNodeRequestModel,
+) (models.GetNodeResponseModel, error) {
+
+	node, err := clientset.CoreV1().Nodes().Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return models.GetNodeResponseModel{}, err
+	}
+
+	pods, err := listNodePods(clientset, req.Name)
+	if err != nil {
+		return models.GetNodeResponseModel{}, err
+	}
+
+	resp := models.GetNodeResponseModel{}
+	resp.ListNodesResponseModelNode = summarizeNode(node, pods.Items)
+	resp.OSImage = node.Status.NodeInfo.OSImage
+	resp.ContainerRuntime = node.Status.NodeInfo.ContainerRuntimeVersion
+
+	resp.Addresses = map[string]string{}
+	for _, address := range node.Status.Addresses {
+		resp.Addresses[string(address.Type)] = address.Address
+	}
+
+	resp.Pods = []models.ListPodsV2ResponseModelPod{}
+	for _, poddata := range pods.Items {
+		resp.Pods = append(resp.Pods, models.ListPodsV2ResponseModelPod{
+			Name:      poddata.Name,
+			Namespace: poddata.Namespace,
+			Status:    string(poddata.Status.Phase),
+		})
+	}
+
+	return resp, nil
+
+}
+
+// mark node (un)schedulable, running pods are not affected
+func SetNodeUnschedulable(
+	clientset kubernetes.Interface, name string, unschedulable bool,
+) error {
+
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := clientset.CoreV1().Nodes().Patch(
+		context.TODO(), name, types.StrategicMergePatchType, []byte(patch),
+		metaapiv1.PatchOptions{},
+	)
+
+	return err
+
+}
+
+// pods which would be recreated on the same node or can't be evicted at all,
+// blocked tells the pod is only skipped because the request didn't allow to
+// lose it (same as kubectl drain without --force or --delete-emptydir-data)
+func drainSkipReason(
+	pod *coreapiv1.Pod, req *models.DrainNodeRequestModel,
+) (reason string, blocked bool) {
+
+	if _, ok := pod.Annotations[coreapiv1.MirrorPodAnnotationKey]; ok {
+		return "static pod", false
+	}
+
+	controllerRef := metaapiv1.GetControllerOf(pod)
+	if controllerRef != nil && controllerRef.Kind == "DaemonSet" {
+		return "managed by DaemonSet", false
+	}
+
+	// nothing is lost when finished pods are deleted
+	if isPodTerminated(pod) {
+		return "", false
+	}
+
+	if controllerRef == nil && !req.Force {
+		return "not managed by a controller, it's not recreated (set force to evict)", true
+	}
+
+	if !req.DeleteEmptyDirData {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.EmptyDir != nil {
+				return "uses emptyDir volume " + volume.Name +
+					", its data is deleted (set delete_emptydir_data to evict)", true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// wait for the context or interval, returns false when the context is done
+func sleepCtx(ctx context.Context, interval time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(interval):
+		return true
+	}
+}
+
+// evict the pod, retrying while PodDisruptionBudget doesn't allow it,
+// and wait until it's gone from the node
+func evictPod(ctx context.Context, clientset kubernetes.Interface, pod *coreapiv1.Pod) error {
+
+	eviction := &policyapiv1.Eviction{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+
+	for {
+		err := clientset.PolicyV1().Evictions(pod.Namespace).Evict(ctx, eviction)
+		if err == nil || apierrors.IsNotFound(err) {
+			break
+		}
+		// API server answers 429 when PodDisruptionBudget blocks the eviction
+		if !apierrors.IsTooManyRequests(err) {
+			return err
+		}
+		if !sleepCtx(ctx, drainRetryInterval) {
+			return fmt.Errorf("eviction blocked by PodDisruptionBudget: %v", err)
+		}
+	}
+
+	for {
+		current, err := clientset.CoreV1().Pods(pod.Namespace).Get(
+			ctx, pod.Name, metaapiv1.GetOptions{},
+		)
+		// pod with the same name but other UID is a replacement
+		if apierrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if !sleepCtx(ctx, drainPollInterval) {
+			return fmt.Errorf("timed out waiting for pod termination")
+		}
+	}
+}
+
+func drainNode(
+	op *Operation, clientset kubernetes.Interface,
+	req *models.DrainNodeRequestModel, timeout time.Duration,
+) error {
+
+	name := req.Name
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := SetNodeUnschedulable(clientset, name, true)
+	if err != nil {
+		return err
+	}
+
+	pods, err := listNodePods(clientset, name)
+	if err != nil {
+		return err
+	}
+
+	// pods are evicted in parallel, same as kubectl drain does
+	var wg sync.WaitGroup
+	var failedMu sync.Mutex
+	failed := 0
+	blocked := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		if reason, block := drainSkipReason(pod, req); reason != "" {
+			op.addItem(pod.Namespace, pod.Name, OperationItemSkipped, reason)
+			if block {
+				blocked++
+			}
+			continue
+		}
+
+		index := op.addItem(pod.Namespace, pod.Name, OperationItemPending, "")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			op.setItem(index, OperationRunning, "evicting")
+			err := evictPod(ctx, clientset, pod)
+			if err != nil {
+				op.setItem(index, OperationFailed, err.Error())
+				failedMu.Lock()
+				failed++
+				failedMu.Unlock()
+				return
+			}
+			op.setItem(index, OperationSucceeded, "evicted")
+		}()
+	}
+	wg.Wait()
+
+	if failed > 0 {
+		return fmt.Errorf("%d pod(s) could not be evicted", failed)
+	}
+	// the node isn't drained while these pods still run on it
+	if blocked > 0 {
+		return fmt.Errorf(
+			"%d pod(s) were not evicted, set force or delete_emptydir_data to evict them",
+			blocked,
+		)
+	}
+
+	return nil
+}
+
+// cordon the node and evict its pods in background, returns the operation
+// which can be polled with GetOperation
+func DrainNode(
+	clientset kubernetes.Interface,
+	cluster string,
+	req *models.DrainNodeRequestModel,
+) (models.OperationModel, error) {
+
+	// fail early when the node doesn't exist
+	_, err := clientset.CoreV1().Nodes().Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return models.OperationModel{}, err
+	}
+
+	timeout := drainDefaultTimeout
+	if req.TimeoutSeconds != 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	op := newOperation(cluster, "drain", "node/"+req.Name)
+	go func() {
+		op.finish(drainNode(op, clientset, req, timeout))
+	}()
+
+	return op.Snapshot(), nil
+
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"testing"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func TestDrainSkipReason(t *testing.T) {
+
+	isController := true
+	owned := func(kind string) []metaapiv1.OwnerReference {
+		return []metaapiv1.OwnerReference{{Kind: kind, Name: "owner", Controller: &isController}}
+	}
+	emptyDir := []coreapiv1.Volume{{
+		Name:         "cache",
+		VolumeSource: coreapiv1.VolumeSource{EmptyDir: &coreapiv1.EmptyDirVolumeSource{}},
+	}}
+
+	tests := []struct {
+		name    string
+		pod     coreapiv1.Pod
+		req     models.DrainNodeRequestModel
+		skipped bool
+		blocked bool
+	}{
+		{
+			name: "managed by ReplicaSet",
+			pod:  coreapiv1.Pod{ObjectMeta: metaapiv1.ObjectMeta{OwnerReferences: owned("ReplicaSet")}},
+		},
+		{
+			name:    "managed by DaemonSet",
+			pod:     coreapiv1.Pod{ObjectMeta: metaapiv1.ObjectMeta{OwnerReferences: owned("DaemonSet")}},
+			req:     models.DrainNodeRequestModel{Force: true},
+			skipped: true,
+		},
+		{
+			name: "static pod",
+			pod: coreapiv1.Pod{ObjectMeta: metaapiv1.ObjectMeta{
+				Annotations: map[string]string{coreapiv1.MirrorPodAnnotationKey: "hash"},
+			}},
+			req:     models.DrainNodeRequestModel{Force: true},
+			skipped: true,
+		},
+		{
+			name:    "unmanaged",
+			pod:     coreapiv1.Pod{},
+			skipped: true,
+			blocked: true,
+		},
+		{
+			name: "unmanaged with force",
+			pod:  coreapiv1.Pod{},
+			req:  models.DrainNodeRequestModel{Force: true},
+		},
+		{
+			name: "unmanaged and finished",
+			pod:  coreapiv1.Pod{Status: coreapiv1.PodStatus{Phase: coreapiv1.PodSucceeded}},
+		},
+		{
+			name: "emptyDir",
+			pod: coreapiv1.Pod{
+				ObjectMeta: metaapiv1.ObjectMeta{OwnerReferences: owned("ReplicaSet")},
+				Spec:       coreapiv1.PodSpec{Volumes: emptyDir},
+			},
+			skipped: true,
+			blocked: true,
+		},
+		{
+			name: "emptyDir with force only",
+			pod: coreapiv1.Pod{
+				ObjectMeta: metaapiv1.ObjectMeta{OwnerReferences: owned("ReplicaSet")},
+				Spec:       coreapiv1.PodSpec{Volumes: emptyDir},
+			},
+			req:     models.DrainNodeRequestModel{Force: true},
+			skipped: true,
+			blocked: true,
+		},
+		{
+			name: "emptyDir with delete_emptydir_data",
+			pod: coreapiv1.Pod{
+				ObjectMeta: metaapiv1.ObjectMeta{OwnerReferences: owned("ReplicaSet")},
+				Spec:       coreapiv1.PodSpec{Volumes: emptyDir},
+			},
+			req: models.DrainNodeRequestModel{DeleteEmptyDirData: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reason, blocked := drainSkipReason(&test.pod, &test.req)
+			if (reason != "") != test.skipped || blocked != test.blocked {
+				t.Fatalf("expected skipped %v blocked %v, got %q blocked %v",
+					test.skipped, test.blocked, reason, blocked)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	OperationRunning   = "Running"
+	OperationSucceeded = "Succeeded"
+	OperationFailed    = "Failed"
+
+	OperationItemPending = "Pending"
+	OperationItemSkipped = "Skipped"
+)
+
+// finished operations are forgotten after this time
+const operationRetention = time.Hour
+
+// long running job (ex. node drain) which progress can be polled by the user
+type Operation struct {
+	mu    sync.Mutex
+	state models.OperationModel
+	end   time.Time
+}
+
+var (
+	operationsMu sync.Mutex
+	operations   = map[string]*Operation{}
+)
+
+// register new running operation in the cluster
+func newOperation(cluster string, kind string, target string) *Operation {
+
+	operationsMu.Lock()
+	defer operationsMu.Unlock()
+
+	// drop old finished operations so the map doesn't grow forever
+	for id, op := range operations {
+		op.mu.Lock()
+		expired := !op.end.IsZero() && time.Since(op.end) > operationRetention
+		op.mu.Unlock()
+		if expired {
+			delete(operations, id)
+		}
+	}
+
+	op := &Operation{
+		state: models.OperationModel{
+			ID:        string(uuid.NewUUID()),
+			Cluster:   cluster,
+			Kind:      kind,
+			Target:    target,
+			Status:    OperationRunning,
+			StartTime: time.Now().UTC().Format(time.RFC3339),
+			Items:     []models.OperationItemModel{},
+		},
+	}
+	operations[op.state.ID] = op
+
+	return op
+}
+
+// add item to the operation, returns its index for setItem
+func (op *Operation) addItem(namespace string, name string, status string, message string) int {
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	op.state.Items = append(op.state.Items, models.OperationItemModel{
+		Name:      name,
+		Namespace: namespace,
+		Status:    status,
+		Message:   message,
+	})
+
+	return len(op.state.Items) - 1
+}
+
+func (op *Operation) setItem(index int, status string, message string) {
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	op.state.Items[index].Status = status
+	op.state.Items[index].Message = message
+}
+
+// mark the operation as done, nil err means success
+func (op *Operation) finish(err error) {
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	op.end = time.Now()
+	op.state.EndTime = op.end.UTC().Format(time.RFC3339)
+	if err != nil {
+		op.state.Status = OperationFailed
+		op.state.Error = err.Error()
+	} else {
+		op.state.Status = OperationSucceeded
+	}
+}
+
+// copy of the current state safe to be serialized
+func (op *Operation) Snapshot() models.OperationModel {
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	snapshot := op.state
+	snapshot.Items = append([]models.OperationItemModel{}, op.state.Items...)
+
+	return snapshot
+}
+
+// operation of the cluster, operations of other clusters are not found
+func GetOperation(cluster string, id string) (models.OperationModel, error) {
+
+	operationsMu.Lock()
+	op, ok := operations[id]
+	operationsMu.Unlock()
+
+	if !ok {
+		return models.OperationModel{}, fmt.Errorf(
+			"%w: operation %q not found", ErrInvalidRequest, id,
+		)
+	}
+	snapshot := op.Snapshot()
+	if snapshot.Cluster != cluster {
+		return models.OperationModel{}, fmt.Errorf(
+			"%w: operation %q not found", ErrInvalidRequest, id,
+		)
+	}
+
+	return snapshot, nil
+}
+
+func ListOperations(cluster string) models.ListOperationsResponseModel {
+
+	operationsMu.Lock()
+	resp := models.ListOperationsResponseModel{}
+	resp.Operations = []models.OperationModel{}
+	for _, op := range operations {
+		snapshot := op.Snapshot()
+		if snapshot.Cluster == cluster {
+			resp.Operations = append(resp.Operations, snapshot)
+		}
+	}
+	operationsMu.Unlock()
+
+	// newest first
+	sort.Slice(resp.Operations, func(i, j int) bool {
+		return resp.Operations[i].StartTime > resp.Operations[j].StartTime
+	})
+
+	return resp
+}
@@ -0,0 +1,475 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	networkingapiv1 "k8s.io/api/networking/v1"
+	policyapiv1 "k8s.io/api/policy/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// number or percentage of pods given by the user, ex. 1 or 50%
+func parseIntOrPercent(field string, value string) (*intstr.IntOrString, error) {
+
+	parsed := intstr.Parse(value)
+	if parsed.Type == intstr.Int && parsed.IntVal < 0 {
+		return nil, fmt.Errorf("%w: %s must not be negative", ErrInvalidRequest, field)
+	}
+	if parsed.Type == intstr.String {
+		_, err := intstr.GetScaledValueFromIntOrPercent(&parsed, 100, false)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%w: %s must be a number or percentage", ErrInvalidRequest, field,
+			)
+		}
+	}
+
+	return &parsed, nil
+}
+
+func formatIntOrPercent(value *intstr.IntOrString) string {
+
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+// selector as label query, all when it selects everything
+func formatSelector(selector *metaapiv1.LabelSelector) string {
+
+	formatted := metaapiv1.FormatLabelSelector(selector)
+	if formatted == "" || formatted == "<none>" {
+		return "all"
+	}
+
+	return formatted
+}
+
+func ListPDBs(
+	clientset kubernetes.Interface,
+	req *models.ListPDBsRequestModel,
+) (models.ListPDBsResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListPDBsResponseModel{}, err
+	}
+	pdbList, err := clientset.PolicyV1().PodDisruptionBudgets(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListPDBsResponseModel{}, listError(err)
+	}
+	err = sortObjects(pdbList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListPDBsResponseModel{}, err
+	}
+
+	resp := models.ListPDBsResponseModel{}
+	resp.PDBs = []models.ListPDBsResponseModelPDB{}
+	for _, pdb := range pdbList.Items {
+		resp.PDBs = append(resp.PDBs, models.ListPDBsResponseModelPDB{
+			Name:               pdb.Name,
+			Namespace:          pdb.Namespace,
+			Selector:           formatSelector(pdb.Spec.Selector),
+			MinAvailable:       formatIntOrPercent(pdb.Spec.MinAvailable),
+			MaxUnavailable:     formatIntOrPercent(pdb.Spec.MaxUnavailable),
+			CurrentHealthy:     pdb.Status.CurrentHealthy,
+			DesiredHealthy:     pdb.Status.DesiredHealthy,
+			ExpectedPods:       pdb.Status.ExpectedPods,
+			DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+		})
+	}
+	resp.Pagination = pagination(pdbList.ListMeta, len(resp.PDBs))
+
+	return resp, nil
+
+}
+
+// set selector and the budget of the PDB spec from the request,
+// exactly one of min available and max unavailable is allowed
+func applyPDBSpec(
+	spec *policyapiv1.PodDisruptionBudgetSpec,
+	req *models.PDBModel,
+) error {
+
+	if (req.MinAvailable == "") == (req.MaxUnavailable == "") {
+		return fmt.Errorf(
+			"%w: exactly one of min_available and max_unavailable is required",
+			ErrInvalidRequest,
+		)
+	}
+
+	spec.Selector = &metaapiv1.LabelSelector{MatchLabels: req.Selector}
+	spec.MinAvailable = nil
+	spec.MaxUnavailable = nil
+
+	var err error
+	if req.MinAvailable != "" {
+		spec.MinAvailable, err = parseIntOrPercent("min_available", req.MinAvailable)
+	} else {
+		spec.MaxUnavailable, err = parseIntOrPercent("max_unavailable", req.MaxUnavailable)
+	}
+
+	return err
+}
+
+func CreatePDB(
+	clientset kubernetes.Interface,
+	req *models.PDBModel,
+) error {
+
+	pdb := &policyapiv1.PodDisruptionBudget{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+	}
+	err := applyPDBSpec(&pdb.Spec, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.PolicyV1().PodDisruptionBudgets(req.Namespace).Create(
+		context.TODO(), pdb, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdatePDB(
+	clientset kubernetes.Interface,
+	req *models.PDBModel,
+) error {
+
+	pdb, err := clientset.PolicyV1().PodDisruptionBudgets(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = applyPDBSpec(&pdb.Spec, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.PolicyV1().PodDisruptionBudgets(req.Namespace).Update(
+		context.TODO(), pdb, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+func DeletePDB(
+	clientset kubernetes.Interface,
+	req *models.DeletePDBRequestModel,
+) error {
+
+	err := clientset.PolicyV1().PodDisruptionBudgets(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
+
+// port number or name, empty port and protocol match everything
+func formatPolicyPort(port *networkingapiv1.NetworkPolicyPort) string {
+
+	protocol := string(coreapiv1.ProtocolTCP)
+	if port.Protocol != nil {
+		protocol = string(*port.Protocol)
+	}
+	if port.Port == nil {
+		return protocol + "/all"
+	}
+	if port.EndPort != nil {
+		return fmt.Sprintf("%s/%s-%d", protocol, port.Port.String(), *port.EndPort)
+	}
+
+	return protocol + "/" + port.Port.String()
+}
+
+func formatPolicyPeer(peer *networkingapiv1.NetworkPolicyPeer) string {
+
+	if peer.IPBlock != nil {
+		if len(peer.IPBlock.Except) == 0 {
+			return "ip " + peer.IPBlock.CIDR
+		}
+		return fmt.Sprintf(
+			"ip %s except %s", peer.IPBlock.CIDR, strings.Join(peer.IPBlock.Except, ", "),
+		)
+	}
+	if peer.NamespaceSelector == nil {
+		return "pods " + formatSelector(peer.PodSelector)
+	}
+
+	return fmt.Sprintf(
+		"pods %s in namespaces %s",
+		formatSelector(peer.PodSelector), formatSelector(peer.NamespaceSelector),
+	)
+}
+
+func formatPolicyRule(
+	peers []networkingapiv1.NetworkPolicyPeer,
+	ports []networkingapiv1.NetworkPolicyPort,
+) models.NetworkPolicyRuleSummaryModel {
+
+	rule := models.NetworkPolicyRuleSummaryModel{Peers: []string{}, Ports: []string{}}
+	for i := range peers {
+		rule.Peers = append(rule.Peers, formatPolicyPeer(&peers[i]))
+	}
+	for i := range ports {
+		rule.Ports = append(rule.Ports, formatPolicyPort(&ports[i]))
+	}
+
+	return rule
+}
+
+func ListNetworkPolicies(
+	clientset kubernetes.Interface,
+	req *models.ListNetworkPoliciesRequestModel,
+) (models.ListNetworkPoliciesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListNetworkPoliciesResponseModel{}, err
+	}
+	policyList, err := clientset.NetworkingV1().NetworkPolicies(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListNetworkPoliciesResponseModel{}, listError(err)
+	}
+	err = sortObjects(policyList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListNetworkPoliciesResponseModel{}, err
+	}
+
+	resp := models.ListNetworkPoliciesResponseModel{}
+	resp.NetworkPolicies = []models.ListNetworkPoliciesResponseModelPolicy{}
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		summary := models.ListNetworkPoliciesResponseModelPolicy{
+			Name:        policy.Name,
+			Namespace:   policy.Namespace,
+			PodSelector: formatSelector(&policy.Spec.PodSelector),
+			PolicyTypes: []string{},
+			Ingress:     []models.NetworkPolicyRuleSummaryModel{},
+			Egress:      []models.NetworkPolicyRuleSummaryModel{},
+		}
+		for _, policyType := range effectivePolicyTypes(policy) {
+			summary.PolicyTypes = append(summary.PolicyTypes, string(policyType))
+		}
+		for _, rule := range policy.Spec.Ingress {
+			summary.Ingress = append(summary.Ingress, formatPolicyRule(rule.From, rule.Ports))
+		}
+		for _, rule := range policy.Spec.Egress {
+			summary.Egress = append(summary.Egress, formatPolicyRule(rule.To, rule.Ports))
+		}
+		resp.NetworkPolicies = append(resp.NetworkPolicies, summary)
+	}
+	resp.Pagination = pagination(policyList.ListMeta, len(resp.NetworkPolicies))
+
+	return resp, nil
+
+}
+
+func buildPolicyPeers(
+	peers []models.NetworkPolicyPeerModel,
+) ([]networkingapiv1.NetworkPolicyPeer, error) {
+
+	built := []networkingapiv1.NetworkPolicyPeer{}
+	for _, peer := range peers {
+		if peer.CIDR != "" {
+			if peer.PodSelector != nil || peer.NamespaceSelector != nil {
+				return nil, fmt.Errorf(
+					"%w: peer with cidr can't have pod or namespace selector", ErrInvalidRequest,
+				)
+			}
+			for _, cidr := range append([]string{peer.CIDR}, peer.Except...) {
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					return nil, fmt.Errorf("%w: invalid cidr %q", ErrInvalidRequest, cidr)
+				}
+			}
+			built = append(built, networkingapiv1.NetworkPolicyPeer{
+				IPBlock: &networkingapiv1.IPBlock{CIDR: peer.CIDR, Except: peer.Except},
+			})
+			continue
+		}
+
+		if peer.PodSelector == nil && peer.NamespaceSelector == nil {
+			return nil, fmt.Errorf(
+				"%w: peer needs pod_selector, namespace_selector or cidr", ErrInvalidRequest,
+			)
+		}
+		built = append(built, networkingapiv1.NetworkPolicyPeer{})
+		if peer.PodSelector != nil {
+			built[len(built)-1].PodSelector = &metaapiv1.LabelSelector{
+				MatchLabels: peer.PodSelector,
+			}
+		}
+		if peer.NamespaceSelector != nil {
+			built[len(built)-1].NamespaceSelector = &metaapiv1.LabelSelector{
+				MatchLabels: peer.NamespaceSelector,
+			}
+		}
+	}
+
+	return built, nil
+}
+
+func buildPolicyPorts(
+	ports []models.NetworkPolicyPortModel,
+) ([]networkingapiv1.NetworkPolicyPort, error) {
+
+	built := []networkingapiv1.NetworkPolicyPort{}
+	for _, port := range ports {
+		policyPort := networkingapiv1.NetworkPolicyPort{}
+		if port.Protocol != "" {
+			protocol := coreapiv1.Protocol(port.Protocol)
+			policyPort.Protocol = &protocol
+		}
+
+		if port.Port != "" {
+			value := intstr.Parse(port.Port)
+			if value.Type == intstr.Int && len(validation.IsValidPortNum(int(value.IntVal))) > 0 {
+				return nil, fmt.Errorf("%w: invalid port %s", ErrInvalidRequest, port.Port)
+			}
+			if value.Type == intstr.String && len(validation.IsValidPortName(value.StrVal)) > 0 {
+				return nil, fmt.Errorf("%w: invalid port name %s", ErrInvalidRequest, port.Port)
+			}
+			policyPort.Port = &value
+		}
+
+		if port.EndPort != 0 {
+			if policyPort.Port == nil || policyPort.Port.Type != intstr.Int ||
+				port.EndPort < policyPort.Port.IntVal {
+				return nil, fmt.Errorf(
+					"%w: end_port needs numeric port not greater than it", ErrInvalidRequest,
+				)
+			}
+			endPort := port.EndPort
+			policyPort.EndPort = &endPort
+		}
+
+		built = append(built, policyPort)
+	}
+
+	return built, nil
+}
+
+// set pod selector, policy types and rules of the network policy spec
+// from the request
+func applyNetworkPolicySpec(
+	spec *networkingapiv1.NetworkPolicySpec,
+	req *models.NetworkPolicyModel,
+) error {
+
+	spec.PodSelector = metaapiv1.LabelSelector{MatchLabels: req.PodSelector}
+
+	spec.PolicyTypes = []networkingapiv1.PolicyType{}
+	for _, policyType := range req.PolicyTypes {
+		spec.PolicyTypes = append(spec.PolicyTypes, networkingapiv1.PolicyType(policyType))
+	}
+
+	spec.Ingress = []networkingapiv1.NetworkPolicyIngressRule{}
+	for _, rule := range req.Ingress {
+		peers, err := buildPolicyPeers(rule.Peers)
+		if err != nil {
+			return err
+		}
+		ports, err := buildPolicyPorts(rule.Ports)
+		if err != nil {
+			return err
+		}
+		spec.Ingress = append(spec.Ingress, networkingapiv1.NetworkPolicyIngressRule{
+			From: peers, Ports: ports,
+		})
+	}
+
+	spec.Egress = []networkingapiv1.NetworkPolicyEgressRule{}
+	for _, rule := range req.Egress {
+		peers, err := buildPolicyPeers(rule.Peers)
+		if err != nil {
+			return err
+		}
+		ports, err := buildPolicyPorts(rule.Ports)
+		if err != nil {
+			return err
+		}
+		spec.Egress = append(spec.Egress, networkingapiv1.NetworkPolicyEgressRule{
+			To: peers, Ports: ports,
+		})
+	}
+
+	return nil
+}
+
+func CreateNetworkPolicy(
+	clientset kubernetes.Interface,
+	req *models.NetworkPolicyModel,
+) error {
+
+	policy := &networkingapiv1.NetworkPolicy{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+	}
+	err := applyNetworkPolicySpec(&policy.Spec, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.NetworkingV1().NetworkPolicies(req.Namespace).Create(
+		context.TODO(), policy, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func UpdateNetworkPolicy(
+	clientset kubernetes.Interface,
+	req *models.NetworkPolicyModel,
+) error {
+
+	policy, err := clientset.NetworkingV1().NetworkPolicies(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = applyNetworkPolicySpec(&policy.Spec, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = clientset.NetworkingV1().NetworkPolicies(req.Namespace).Update(
+		context.TODO(), policy, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
+
+func DeleteNetworkPolicy(
+	clientset kubernetes.Interface,
+	req *models.DeleteNetworkPolicyRequestModel,
+) error {
+
+	err := clientset.NetworkingV1().NetworkPolicies(req.Namespace).Delete(
+		context.TODO(), req.Name, metaapiv1.DeleteOptions{},
+	)
+	return err
+
+}
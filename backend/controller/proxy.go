@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	ProxyKindPods     = "pods"
+	ProxyKindServices = "services"
+)
+
+// headers which only apply to one connection, they are never forwarded
+// except Connection and Upgrade of upgrade requests (ex. WebSocket)
+var proxyHopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// verbs of the proxy subresource checked for the request methods
+var proxyVerbs = map[string]string{
+	http.MethodGet:    "get",
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// client for requests to the API server proxy, upgrades need HTTP/1.1
+// so the connection can be hijacked after 101 Switching Protocols
+type proxyClient struct {
+	server    *url.URL
+	transport http.RoundTripper
+}
+
+// proxy client authenticated (and impersonating) the same way as the
+// clientsets created from the config
+func newProxyClient(config *rest.Config) (*proxyClient, error) {
+
+	server, _, err := rest.DefaultServerUrlFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing server address: %v", err)
+	}
+
+	tlsConfig, err := rest.TLSConfigFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating TLS config: %v", err)
+	}
+	if tlsConfig != nil {
+		tlsConfig.NextProtos = []string{"http/1.1"}
+	}
+
+	transport := utilnet.SetOldTransportDefaults(&http.Transport{
+		TLSClientConfig: tlsConfig,
+	})
+	if config.Proxy != nil {
+		transport.Proxy = config.Proxy
+	}
+
+	roundTripper, err := rest.HTTPWrappersForConfig(config, transport)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proxy transport: %v", err)
+	}
+
+	return &proxyClient{server: server, transport: roundTripper}, nil
+}
+
+func validateProxyRequest(req *models.ProxyRequestModel) error {
+
+	if req.Kind != ProxyKindPods && req.Kind != ProxyKindServices {
+		return fmt.Errorf("%w: kind must be pods or services", ErrInvalidRequest)
+	}
+	if errs := validation.IsDNS1123Label(req.Namespace); len(errs) > 0 {
+		return fmt.Errorf("%w: invalid namespace: %s", ErrInvalidRequest, errs[0])
+	}
+	if errs := validation.IsDNS1123Subdomain(req.Name); len(errs) > 0 {
+		return fmt.Errorf("%w: invalid name: %s", ErrInvalidRequest, errs[0])
+	}
+
+	// port number or name of the container or service port
+	if number, err := strconv.Atoi(req.Port); err == nil {
+		if errs := validation.IsValidPortNum(number); len(errs) > 0 {
+			return fmt.Errorf("%w: invalid port: %s", ErrInvalidRequest, errs[0])
+		}
+	} else if errs := validation.IsValidPortName(req.Port); len(errs) > 0 {
+		return fmt.Errorf("%w: invalid port: %s", ErrInvalidRequest, errs[0])
+	}
+
+	if _, found := proxyVerbs[req.Method]; !found {
+		return fmt.Errorf("%w: method %s can't be proxied", ErrInvalidRequest, req.Method)
+	}
+
+	return nil
+}
+
+func isUpgradeRequest(header http.Header) bool {
+
+	for _, value := range header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// remove hop-by-hop headers (and headers named by Connection), upgrade
+// requests keep Connection and Upgrade so the backend can switch protocols
+func removeHopHeaders(header http.Header, upgrade bool) {
+
+	for _, value := range header.Values("Connection") {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if !strings.EqualFold(name, "upgrade") {
+				header.Del(name)
+			}
+		}
+	}
+	for _, name := range proxyHopHeaders {
+		if upgrade && (name == "Connection" || name == "Upgrade") {
+			continue
+		}
+		header.Del(name)
+	}
+}
+
+// forward the request to the port of the pod or service through the proxy
+// subresource of the API server, the user needs the verb of the method
+// on pods/proxy or services/proxy, the response is returned as it is
+// (101 Switching Protocols with read-write body for upgrades) and the
+// caller has to close its body
+func Proxy(
+	cluster *Cluster, req *models.ProxyRequestModel, header http.Header, body io.Reader,
+) (*http.Response, error) {
+
+	err := validateProxyRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.proxy == nil {
+		return nil, fmt.Errorf("cluster %s doesn't support proxying", cluster.Name)
+	}
+
+	allowed, err := canAccess(
+		cluster, proxyVerbs[req.Method], "", req.Kind, "proxy", req.Namespace, req.Name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf(
+			"%w: %s %s/proxy of %s/%s is not allowed",
+			ErrForbidden, proxyVerbs[req.Method], req.Kind, req.Namespace, req.Name,
+		)
+	}
+
+	// cleaned so the path can't leave the proxy subresource,
+	// the trailing slash is kept as the backend may need it
+	target := path.Clean("/" + req.Path)
+	if strings.HasSuffix(req.Path, "/") && target != "/" {
+		target += "/"
+	}
+
+	server := *cluster.proxy.server
+	server.Path = strings.TrimSuffix(server.Path, "/") + fmt.Sprintf(
+		"/api/v1/namespaces/%s/%s/%s:%s/proxy%s",
+		req.Namespace, req.Kind, req.Name, req.Port, target,
+	)
+	server.RawPath = ""
+	server.RawQuery = req.Query
+
+	request, err := http.NewRequestWithContext(context.Background(), req.Method, server.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	// credentials of the client are for kubedash, the transport
+	// authenticates (and impersonates) on its own
+	request.Header = header.Clone()
+	removeHopHeaders(request.Header, isUpgradeRequest(header))
+	request.Header.Del("Authorization")
+	request.Header.Del("Cookie")
+	for name := range request.Header {
+		if strings.HasPrefix(name, "Impersonate-") {
+			request.Header.Del(name)
+		}
+	}
+	if body == nil {
+		request.ContentLength = 0
+	} else if length := header.Get("Content-Length"); length != "" {
+		request.ContentLength, _ = strconv.ParseInt(length, 10, 64)
+	}
+
+	resp, err := cluster.proxy.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		removeHopHeaders(resp.Header, false)
+	}
+
+	return resp, nil
+}
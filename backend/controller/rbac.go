@@ -0,0 +1,585 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	authorizationapiv1 "k8s.io/api/authorization/v1"
+	rbacapiv1 "k8s.io/api/rbac/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	groupAuthenticated   = "system:authenticated"
+	groupServiceAccounts = "system:serviceaccounts"
+)
+
+func rbacRules(rules []rbacapiv1.PolicyRule) []models.RBACRuleModel {
+
+	summaries := []models.RBACRuleModel{}
+	for _, rule := range rules {
+		summaries = append(summaries, models.RBACRuleModel{
+			Verbs:           append([]string{}, rule.Verbs...),
+			APIGroups:       append([]string{}, rule.APIGroups...),
+			Resources:       append([]string{}, rule.Resources...),
+			ResourceNames:   rule.ResourceNames,
+			NonResourceURLs: rule.NonResourceURLs,
+		})
+	}
+
+	return summaries
+}
+
+func rbacSubjects(subjects []rbacapiv1.Subject) []models.RBACSubjectModel {
+
+	summaries := []models.RBACSubjectModel{}
+	for _, subject := range subjects {
+		summaries = append(summaries, models.RBACSubjectModel{
+			Kind:      subject.Kind,
+			Name:      subject.Name,
+			Namespace: subject.Namespace,
+		})
+	}
+
+	return summaries
+}
+
+func ListRoles(
+	clientset kubernetes.Interface,
+	req *models.ListRolesRequestModel,
+) (models.ListRolesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListRolesResponseModel{}, err
+	}
+	roleList, err := clientset.RbacV1().Roles(req.Namespace).List(context.TODO(), options)
+	if err != nil {
+		return models.ListRolesResponseModel{}, listError(err)
+	}
+	err = sortObjects(roleList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListRolesResponseModel{}, err
+	}
+
+	resp := models.ListRolesResponseModel{}
+	resp.Roles = []models.ListRolesResponseModelRole{}
+	for _, role := range roleList.Items {
+		resp.Roles = append(resp.Roles, models.ListRolesResponseModelRole{
+			Name:      role.Name,
+			Namespace: role.Namespace,
+			Rules:     rbacRules(role.Rules),
+		})
+	}
+	resp.Pagination = pagination(roleList.ListMeta, len(resp.Roles))
+
+	return resp, nil
+
+}
+
+func ListClusterRoles(
+	clientset kubernetes.Interface,
+	req *models.ListClusterRolesRequestModel,
+) (models.ListRolesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListRolesResponseModel{}, err
+	}
+	roleList, err := clientset.RbacV1().ClusterRoles().List(context.TODO(), options)
+	if err != nil {
+		return models.ListRolesResponseModel{}, listError(err)
+	}
+	err = sortObjects(roleList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListRolesResponseModel{}, err
+	}
+
+	resp := models.ListRolesResponseModel{}
+	resp.Roles = []models.ListRolesResponseModelRole{}
+	for _, role := range roleList.Items {
+		resp.Roles = append(resp.Roles, models.ListRolesResponseModelRole{
+			Name:  role.Name,
+			Rules: rbacRules(role.Rules),
+		})
+	}
+	resp.Pagination = pagination(roleList.ListMeta, len(resp.Roles))
+
+	return resp, nil
+
+}
+
+func ListRoleBindings(
+	clientset kubernetes.Interface,
+	req *models.ListRoleBindingsRequestModel,
+) (models.ListRoleBindingsResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListRoleBindingsResponseModel{}, err
+	}
+	bindingList, err := clientset.RbacV1().RoleBindings(req.Namespace).List(context.TODO(), options)
+	if err != nil {
+		return models.ListRoleBindingsResponseModel{}, listError(err)
+	}
+	err = sortObjects(bindingList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListRoleBindingsResponseModel{}, err
+	}
+
+	resp := models.ListRoleBindingsResponseModel{}
+	resp.Bindings = []models.ListRoleBindingsResponseModelBinding{}
+	for _, binding := range bindingList.Items {
+		resp.Bindings = append(resp.Bindings, models.ListRoleBindingsResponseModelBinding{
+			Name:      binding.Name,
+			Namespace: binding.Namespace,
+			RoleKind:  binding.RoleRef.Kind,
+			RoleName:  binding.RoleRef.Name,
+			Subjects:  rbacSubjects(binding.Subjects),
+		})
+	}
+	resp.Pagination = pagination(bindingList.ListMeta, len(resp.Bindings))
+
+	return resp, nil
+
+}
+
+func ListClusterRoleBindings(
+	clientset kubernetes.Interface,
+	req *models.ListClusterRoleBindingsRequestModel,
+) (models.ListRoleBindingsResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListRoleBindingsResponseModel{}, err
+	}
+	bindingList, err := clientset.RbacV1().ClusterRoleBindings().List(context.TODO(), options)
+	if err != nil {
+		return models.ListRoleBindingsResponseModel{}, listError(err)
+	}
+	err = sortObjects(bindingList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListRoleBindingsResponseModel{}, err
+	}
+
+	resp := models.ListRoleBindingsResponseModel{}
+	resp.Bindings = []models.ListRoleBindingsResponseModelBinding{}
+	for _, binding := range bindingList.Items {
+		resp.Bindings = append(resp.Bindings, models.ListRoleBindingsResponseModelBinding{
+			Name:     binding.Name,
+			RoleKind: binding.RoleRef.Kind,
+			RoleName: binding.RoleRef.Name,
+			Subjects: rbacSubjects(binding.Subjects),
+		})
+	}
+	resp.Pagination = pagination(bindingList.ListMeta, len(resp.Bindings))
+
+	return resp, nil
+
+}
+
+func ListServiceAccounts(
+	clientset kubernetes.Interface,
+	req *models.ListServiceAccountsRequestModel,
+) (models.ListServiceAccountsResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListServiceAccountsResponseModel{}, err
+	}
+	accountList, err := clientset.CoreV1().ServiceAccounts(req.Namespace).List(context.TODO(), options)
+	if err != nil {
+		return models.ListServiceAccountsResponseModel{}, listError(err)
+	}
+	err = sortObjects(accountList.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListServiceAccountsResponseModel{}, err
+	}
+
+	resp := models.ListServiceAccountsResponseModel{}
+	resp.ServiceAccounts = []models.ListServiceAccountsResponseModelServiceAccount{}
+	for _, account := range accountList.Items {
+		summary := models.ListServiceAccountsResponseModelServiceAccount{
+			Name:             account.Name,
+			Namespace:        account.Namespace,
+			Secrets:          []string{},
+			ImagePullSecrets: []string{},
+			AutomountToken:   account.AutomountServiceAccountToken,
+		}
+		for _, secret := range account.Secrets {
+			summary.Secrets = append(summary.Secrets, secret.Name)
+		}
+		for _, secret := range account.ImagePullSecrets {
+			summary.ImagePullSecrets = append(summary.ImagePullSecrets, secret.Name)
+		}
+		resp.ServiceAccounts = append(resp.ServiceAccounts, summary)
+	}
+	resp.Pagination = pagination(accountList.ListMeta, len(resp.ServiceAccounts))
+
+	return resp, nil
+
+}
+
+// role or cluster role binding with the rules of its role resolved,
+// bindings to missing roles grant nothing
+type rbacBinding struct {
+	grant    models.RBACGrantModel
+	subjects []rbacapiv1.Subject
+	rules    []rbacapiv1.PolicyRule
+}
+
+// resolve the roles of the bindings, role bindings can reference roles
+// of their namespace or cluster roles which then apply to the namespace only
+func resolveBindings(
+	roles []rbacapiv1.Role,
+	clusterRoles []rbacapiv1.ClusterRole,
+	roleBindings []rbacapiv1.RoleBinding,
+	clusterRoleBindings []rbacapiv1.ClusterRoleBinding,
+) []rbacBinding {
+
+	roleRules := map[string][]rbacapiv1.PolicyRule{}
+	for _, role := range roles {
+		roleRules[role.Namespace+"/"+role.Name] = role.Rules
+	}
+	clusterRoleRules := map[string][]rbacapiv1.PolicyRule{}
+	for _, role := range clusterRoles {
+		clusterRoleRules[role.Name] = role.Rules
+	}
+
+	bindings := []rbacBinding{}
+	for _, binding := range clusterRoleBindings {
+		rules, ok := clusterRoleRules[binding.RoleRef.Name]
+		if !ok || binding.RoleRef.Kind != "ClusterRole" {
+			continue
+		}
+		bindings = append(bindings, rbacBinding{
+			grant: models.RBACGrantModel{
+				BindingKind: "ClusterRoleBinding",
+				BindingName: binding.Name,
+				RoleKind:    binding.RoleRef.Kind,
+				RoleName:    binding.RoleRef.Name,
+			},
+			subjects: binding.Subjects,
+			rules:    rules,
+		})
+	}
+	for _, binding := range roleBindings {
+		var rules []rbacapiv1.PolicyRule
+		var ok bool
+		switch binding.RoleRef.Kind {
+		case "Role":
+			rules, ok = roleRules[binding.Namespace+"/"+binding.RoleRef.Name]
+		case "ClusterRole":
+			rules, ok = clusterRoleRules[binding.RoleRef.Name]
+		}
+		if !ok {
+			continue
+		}
+		bindings = append(bindings, rbacBinding{
+			grant: models.RBACGrantModel{
+				Namespace:   binding.Namespace,
+				BindingKind: "RoleBinding",
+				BindingName: binding.Name,
+				RoleKind:    binding.RoleRef.Kind,
+				RoleName:    binding.RoleRef.Name,
+			},
+			subjects: binding.Subjects,
+			rules:    rules,
+		})
+	}
+
+	return bindings
+}
+
+// load the bindings granting anything in the namespace, only cluster role
+// bindings grant cluster wide requests so role bindings are skipped when
+// namespace is empty, all bindings are loaded when all is set
+func loadBindings(
+	clientset kubernetes.Interface, namespace string, all bool,
+) ([]rbacBinding, error) {
+
+	clusterRoles, err := clientset.RbacV1().ClusterRoles().List(context.TODO(), metaapiv1.ListOptions{})
+	if err != nil {
+		return nil, listError(err)
+	}
+	clusterRoleBindings, err := clientset.RbacV1().ClusterRoleBindings().List(
+		context.TODO(), metaapiv1.ListOptions{},
+	)
+	if err != nil {
+		return nil, listError(err)
+	}
+
+	roles := []rbacapiv1.Role{}
+	roleBindings := []rbacapiv1.RoleBinding{}
+	if namespace != "" || all {
+		roleList, err := clientset.RbacV1().Roles(namespace).List(context.TODO(), metaapiv1.ListOptions{})
+		if err != nil {
+			return nil, listError(err)
+		}
+		bindingList, err := clientset.RbacV1().RoleBindings(namespace).List(
+			context.TODO(), metaapiv1.ListOptions{},
+		)
+		if err != nil {
+			return nil, listError(err)
+		}
+		roles, roleBindings = roleList.Items, bindingList.Items
+	}
+
+	return resolveBindings(roles, clusterRoles.Items, roleBindings, clusterRoleBindings.Items), nil
+}
+
+func matchesAny(values []string, value string) bool {
+
+	for _, v := range values {
+		if v == rbacapiv1.VerbAll || v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// same matching as the RBAC authorizer, "*/subresource" matches the
+// subresource of any resource and rules with resource names match only
+// requests for one of those objects
+func ruleAllows(rule *rbacapiv1.PolicyRule, req *models.WhoCanRequestModel) bool {
+
+	if !matchesAny(rule.Verbs, req.Verb) || !matchesAny(rule.APIGroups, req.Group) {
+		return false
+	}
+
+	resource := req.Resource
+	if req.Subresource != "" {
+		resource += "/" + req.Subresource
+	}
+	resourceMatches := false
+	for _, ruleResource := range rule.Resources {
+		if ruleResource == rbacapiv1.ResourceAll || ruleResource == resource ||
+			(req.Subresource != "" && ruleResource == "*/"+req.Subresource) {
+			resourceMatches = true
+			break
+		}
+	}
+	if !resourceMatches {
+		return false
+	}
+
+	if len(rule.ResourceNames) == 0 {
+		return true
+	}
+	for _, name := range rule.ResourceNames {
+		if name == req.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func subjectKey(subject *models.RBACSubjectModel) string {
+	return subject.Kind + "/" + subject.Namespace + "/" + subject.Name
+}
+
+// subjects of the bindings allowing the request, role bindings of other
+// namespaces than the one of the request never grant it
+func whoCan(bindings []rbacBinding, req *models.WhoCanRequestModel) models.WhoCanResponseModel {
+
+	subjects := map[string]*models.WhoCanResponseModelSubject{}
+	for _, binding := range bindings {
+		if binding.grant.Namespace != "" && binding.grant.Namespace != req.Namespace {
+			continue
+		}
+		allowed := false
+		for i := range binding.rules {
+			if ruleAllows(&binding.rules[i], req) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			continue
+		}
+
+		for _, bound := range binding.subjects {
+			subject := models.RBACSubjectModel{
+				Kind: bound.Kind, Name: bound.Name, Namespace: bound.Namespace,
+			}
+			key := subjectKey(&subject)
+			if subjects[key] == nil {
+				subjects[key] = &models.WhoCanResponseModelSubject{
+					Kind: subject.Kind, Name: subject.Name, Namespace: subject.Namespace,
+					GrantedBy: []models.RBACGrantModel{},
+				}
+			}
+			subjects[key].GrantedBy = append(subjects[key].GrantedBy, binding.grant)
+		}
+	}
+
+	resp := models.WhoCanResponseModel{Subjects: []models.WhoCanResponseModelSubject{}}
+	for _, subject := range subjects {
+		resp.Subjects = append(resp.Subjects, *subject)
+	}
+	sort.Slice(resp.Subjects, func(i, j int) bool {
+		a, b := resp.Subjects[i], resp.Subjects[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	return resp
+}
+
+// groups the subject is a member of, service accounts are in the groups
+// of all service accounts and of their namespace, every user and service
+// account is in the group of authenticated users
+func subjectGroups(req *models.SubjectPermissionsRequestModel) []string {
+
+	switch req.Kind {
+	case rbacapiv1.GroupKind:
+		return []string{}
+	case rbacapiv1.ServiceAccountKind:
+		return []string{
+			groupServiceAccounts, groupServiceAccounts + ":" + req.Namespace, groupAuthenticated,
+		}
+	}
+
+	groups := append([]string{}, req.Groups...)
+	for _, group := range groups {
+		if group == groupAuthenticated {
+			return groups
+		}
+	}
+
+	return append(groups, groupAuthenticated)
+}
+
+// whether the subject of a binding is the requested subject or one of its
+// groups, service accounts can also be bound as users by their user name
+func subjectMatches(
+	bound *rbacapiv1.Subject, req *models.SubjectPermissionsRequestModel, groups []string,
+) bool {
+
+	switch bound.Kind {
+	case rbacapiv1.GroupKind:
+		if req.Kind == rbacapiv1.GroupKind {
+			return bound.Name == req.Name
+		}
+		for _, group := range groups {
+			if bound.Name == group {
+				return true
+			}
+		}
+	case rbacapiv1.UserKind:
+		switch req.Kind {
+		case rbacapiv1.UserKind:
+			return bound.Name == req.Name
+		case rbacapiv1.ServiceAccountKind:
+			return bound.Name == fmt.Sprintf("system:serviceaccount:%s:%s", req.Namespace, req.Name)
+		}
+	case rbacapiv1.ServiceAccountKind:
+		return req.Kind == rbacapiv1.ServiceAccountKind &&
+			bound.Name == req.Name && bound.Namespace == req.Namespace
+	}
+
+	return false
+}
+
+// rules granted to the subject by the bindings, directly or through its groups
+func subjectPermissions(
+	bindings []rbacBinding, req *models.SubjectPermissionsRequestModel,
+) models.SubjectPermissionsResponseModel {
+
+	resp := models.SubjectPermissionsResponseModel{
+		Groups:      subjectGroups(req),
+		Permissions: []models.SubjectPermissionsResponseModelPermission{},
+	}
+	for _, binding := range bindings {
+		for i := range binding.subjects {
+			bound := &binding.subjects[i]
+			if !subjectMatches(bound, req, resp.Groups) {
+				continue
+			}
+			resp.Permissions = append(resp.Permissions, models.SubjectPermissionsResponseModelPermission{
+				Grant: binding.grant,
+				BoundSubject: models.RBACSubjectModel{
+					Kind: bound.Kind, Name: bound.Name, Namespace: bound.Namespace,
+				},
+				Rules: rbacRules(binding.rules),
+			})
+			break
+		}
+	}
+
+	return resp
+}
+
+// subjects allowed the request by the RBAC objects of the cluster,
+// other authorizers (ex. node or webhook) are not considered
+func WhoCan(
+	clientset kubernetes.Interface,
+	req *models.WhoCanRequestModel,
+) (models.WhoCanResponseModel, error) {
+
+	bindings, err := loadBindings(clientset, req.Namespace, false)
+	if err != nil {
+		return models.WhoCanResponseModel{}, err
+	}
+
+	return whoCan(bindings, req), nil
+}
+
+func SubjectPermissions(
+	clientset kubernetes.Interface,
+	req *models.SubjectPermissionsRequestModel,
+) (models.SubjectPermissionsResponseModel, error) {
+
+	bindings, err := loadBindings(clientset, "", true)
+	if err != nil {
+		return models.SubjectPermissionsResponseModel{}, err
+	}
+
+	return subjectPermissions(bindings, req), nil
+}
+
+// ask the API server whether the user of the clientset can make the request,
+// the kubedash user when impersonating and the server's own credentials otherwise
+func ReviewAccess(
+	clientset kubernetes.Interface,
+	req *models.AccessReviewRequestModel,
+) (models.AccessReviewResponseModel, error) {
+
+	review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(
+		context.TODO(),
+		&authorizationapiv1.SelfSubjectAccessReview{
+			Spec: authorizationapiv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationapiv1.ResourceAttributes{
+					Verb:        req.Verb,
+					Group:       req.Group,
+					Resource:    req.Resource,
+					Subresource: req.Subresource,
+					Namespace:   req.Namespace,
+					Name:        req.Name,
+				},
+			},
+		},
+		metaapiv1.CreateOptions{},
+	)
+	if err != nil {
+		return models.AccessReviewResponseModel{}, err
+	}
+
+	return models.AccessReviewResponseModel{
+		Allowed:         review.Status.Allowed,
+		Denied:          review.Status.Denied,
+		Reason:          review.Status.Reason,
+		EvaluationError: review.Status.EvaluationError,
+	}, nil
+}
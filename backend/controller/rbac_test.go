@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"testing"
+
+	rbacapiv1 "k8s.io/api/rbac/v1"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func testBindings() []rbacBinding {
+
+	clusterRoles := []rbacapiv1.ClusterRole{
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "scaler"},
+			Rules: []rbacapiv1.PolicyRule{{
+				Verbs: []string{"update"}, APIGroups: []string{"*"}, Resources: []string{"*/scale"},
+			}},
+		},
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "nginx-editor"},
+			Rules: []rbacapiv1.PolicyRule{{
+				Verbs: []string{"get", "update"}, APIGroups: []string{"apps"},
+				Resources: []string{"deployments"}, ResourceNames: []string{"nginx"},
+			}},
+		},
+	}
+	roles := []rbacapiv1.Role{{
+		ObjectMeta: metaapiv1.ObjectMeta{Name: "log-reader", Namespace: "payments"},
+		Rules: []rbacapiv1.PolicyRule{{
+			Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/log"},
+		}},
+	}}
+	clusterRoleBindings := []rbacapiv1.ClusterRoleBinding{{
+		ObjectMeta: metaapiv1.ObjectMeta{Name: "autoscalers"},
+		RoleRef:    rbacapiv1.RoleRef{Kind: "ClusterRole", Name: "scaler"},
+		Subjects: []rbacapiv1.Subject{
+			{Kind: rbacapiv1.UserKind, Name: "system:serviceaccount:kube-system:autoscaler"},
+		},
+	}}
+	roleBindings := []rbacapiv1.RoleBinding{
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "edit-nginx", Namespace: "default"},
+			RoleRef:    rbacapiv1.RoleRef{Kind: "ClusterRole", Name: "nginx-editor"},
+			Subjects:   []rbacapiv1.Subject{{Kind: rbacapiv1.GroupKind, Name: "developers"}},
+		},
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "read-logs", Namespace: "payments"},
+			RoleRef:    rbacapiv1.RoleRef{Kind: "Role", Name: "log-reader"},
+			Subjects: []rbacapiv1.Subject{
+				{Kind: rbacapiv1.GroupKind, Name: "system:serviceaccounts:payments"},
+			},
+		},
+		{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "missing-role", Namespace: "default"},
+			RoleRef:    rbacapiv1.RoleRef{Kind: "Role", Name: "missing"},
+			Subjects:   []rbacapiv1.Subject{{Kind: rbacapiv1.UserKind, Name: "jane"}},
+		},
+	}
+
+	return resolveBindings(roles, clusterRoles, roleBindings, clusterRoleBindings)
+}
+
+func TestWhoCan(t *testing.T) {
+
+	bindings := testBindings()
+
+	tests := []struct {
+		name     string
+		req      models.WhoCanRequestModel
+		subjects []string
+	}{
+		{
+			name: "subresource of any resource",
+			req: models.WhoCanRequestModel{
+				Verb: "update", Group: "apps", Resource: "deployments", Subresource: "scale", Namespace: "default",
+			},
+			subjects: []string{"system:serviceaccount:kube-system:autoscaler"},
+		},
+		{
+			name: "named object",
+			req: models.WhoCanRequestModel{
+				Verb: "update", Group: "apps", Resource: "deployments", Namespace: "default", Name: "nginx",
+			},
+			subjects: []string{"developers"},
+		},
+		{
+			name: "other object than the named one",
+			req: models.WhoCanRequestModel{
+				Verb: "update", Group: "apps", Resource: "deployments", Namespace: "default", Name: "web",
+			},
+		},
+		{
+			name: "resource names don't allow requests for any object",
+			req:  models.WhoCanRequestModel{Verb: "get", Group: "apps", Resource: "deployments", Namespace: "default"},
+		},
+		{
+			name: "role binding of other namespace",
+			req: models.WhoCanRequestModel{
+				Verb: "update", Group: "apps", Resource: "deployments", Namespace: "payments", Name: "nginx",
+			},
+		},
+		{
+			name: "role binding doesn't grant cluster wide requests",
+			req:  models.WhoCanRequestModel{Verb: "get", Resource: "pods", Subresource: "log"},
+		},
+		{
+			name:     "role of the namespace",
+			req:      models.WhoCanRequestModel{Verb: "get", Resource: "pods", Subresource: "log", Namespace: "payments"},
+			subjects: []string{"system:serviceaccounts:payments"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := whoCan(bindings, &test.req)
+			if len(resp.Subjects) != len(test.subjects) {
+				t.Fatalf("expected subjects %v, got %+v", test.subjects, resp.Subjects)
+			}
+			for i, subject := range resp.Subjects {
+				if subject.Name != test.subjects[i] {
+					t.Fatalf("expected subjects %v, got %+v", test.subjects, resp.Subjects)
+				}
+			}
+		})
+	}
+}
+
+func TestSubjectPermissions(t *testing.T) {
+
+	bindings := testBindings()
+
+	tests := []struct {
+		name     string
+		req      models.SubjectPermissionsRequestModel
+		bindings []string
+	}{
+		{
+			name:     "service account bound by user name",
+			req:      models.SubjectPermissionsRequestModel{Kind: "ServiceAccount", Name: "autoscaler", Namespace: "kube-system"},
+			bindings: []string{"autoscalers"},
+		},
+		{
+			name:     "service account through group of its namespace",
+			req:      models.SubjectPermissionsRequestModel{Kind: "ServiceAccount", Name: "default", Namespace: "payments"},
+			bindings: []string{"read-logs"},
+		},
+		{
+			name:     "user through its groups",
+			req:      models.SubjectPermissionsRequestModel{Kind: "User", Name: "john", Groups: []string{"developers"}},
+			bindings: []string{"edit-nginx"},
+		},
+		{
+			name: "binding to missing role",
+			req:  models.SubjectPermissionsRequestModel{Kind: "User", Name: "jane"},
+		},
+		{
+			name:     "group",
+			req:      models.SubjectPermissionsRequestModel{Kind: "Group", Name: "developers"},
+			bindings: []string{"edit-nginx"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := subjectPermissions(bindings, &test.req)
+			if len(resp.Permissions) != len(test.bindings) {
+				t.Fatalf("expected bindings %v, got %+v", test.bindings, resp.Permissions)
+			}
+			for i, permission := range resp.Permissions {
+				if permission.Grant.BindingName != test.bindings[i] {
+					t.Fatalf("expected bindings %v, got %+v", test.bindings, resp.Permissions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appsapiv1 "k8s.io/api/apps/v1"
+	batchapiv1 "k8s.io/api/batch/v1"
+	coreapiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	SearchMatchName       = "name"
+	SearchMatchLabel      = "label"
+	SearchMatchAnnotation = "annotation"
+	SearchMatchImage      = "image"
+)
+
+// default number of hits returned from search
+const searchDefaultLimit = 100
+
+// kind served by search, secrets are left out so their data
+// is never kept in memory
+type searchableKind struct {
+	kind string
+	// API group and resource of the kind for access reviews
+	group         string
+	resource      string
+	clusterScoped bool
+	informer      func(factory informers.SharedInformerFactory) cache.SharedIndexInformer
+	// container images used by the object, nil for kinds without pods
+	images func(object interface{}) []string
+}
+
+func podSpecImages(spec *coreapiv1.PodSpec) []string {
+
+	images := []string{}
+	for _, container := range spec.InitContainers {
+		images = append(images, container.Image)
+	}
+	for _, container := range spec.Containers {
+		images = append(images, container.Image)
+	}
+
+	return images
+}
+
+var searchableKinds = []searchableKind{
+	{
+		kind:     "Pod",
+		resource: "pods",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Pods().Informer()
+		},
+		images: func(object interface{}) []string {
+			return podSpecImages(&object.(*coreapiv1.Pod).Spec)
+		},
+	},
+	{
+		kind:     "Deployment",
+		group:    "apps",
+		resource: "deployments",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Apps().V1().Deployments().Informer()
+		},
+		images: func(object interface{}) []string {
+			return podSpecImages(&object.(*appsapiv1.Deployment).Spec.Template.Spec)
+		},
+	},
+	{
+		kind:     "StatefulSet",
+		group:    "apps",
+		resource: "statefulsets",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Apps().V1().StatefulSets().Informer()
+		},
+		images: func(object interface{}) []string {
+			return podSpecImages(&object.(*appsapiv1.StatefulSet).Spec.Template.Spec)
+		},
+	},
+	{
+		kind:     "DaemonSet",
+		group:    "apps",
+		resource: "daemonsets",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Apps().V1().DaemonSets().Informer()
+		},
+		images: func(object interface{}) []string {
+			return podSpecImages(&object.(*appsapiv1.DaemonSet).Spec.Template.Spec)
+		},
+	},
+	{
+		kind:     "Job",
+		group:    "batch",
+		resource: "jobs",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Batch().V1().Jobs().Informer()
+		},
+		images: func(object interface{}) []string {
+			return podSpecImages(&object.(*batchapiv1.Job).Spec.Template.Spec)
+		},
+	},
+	{
+		kind:     "CronJob",
+		group:    "batch",
+		resource: "cronjobs",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Batch().V1().CronJobs().Informer()
+		},
+		images: func(object interface{}) []string {
+			return podSpecImages(&object.(*batchapiv1.CronJob).Spec.JobTemplate.Spec.Template.Spec)
+		},
+	},
+	{
+		kind:     "Service",
+		resource: "services",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Services().Informer()
+		},
+	},
+	{
+		kind:     "Ingress",
+		group:    "networking.k8s.io",
+		resource: "ingresses",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Networking().V1().Ingresses().Informer()
+		},
+	},
+	{
+		kind:     "ConfigMap",
+		resource: "configmaps",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().ConfigMaps().Informer()
+		},
+	},
+	{
+		kind:     "PersistentVolumeClaim",
+		resource: "persistentvolumeclaims",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().PersistentVolumeClaims().Informer()
+		},
+	},
+	{
+		kind:          "Namespace",
+		resource:      "namespaces",
+		clusterScoped: true,
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Namespaces().Informer()
+		},
+	},
+	{
+		kind:          "Node",
+		resource:      "nodes",
+		clusterScoped: true,
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Nodes().Informer()
+		},
+	},
+}
+
+// annotation filter is either key or key=value
+func matchAnnotation(annotations map[string]string, filter string) bool {
+
+	key, value, hasValue := strings.Cut(filter, "=")
+	current, found := annotations[key]
+	if !found {
+		return false
+	}
+
+	return !hasValue || current == value
+}
+
+// search objects in the cache by name substring, label selector, annotation
+// and container image substring, all given criteria have to match,
+// impersonated users get only kinds they can list in the namespace
+func Search(
+	cluster *Cluster,
+	req *models.SearchRequestModel,
+) (models.SearchResponseModel, error) {
+
+	if req.Query == "" && req.LabelSelector == "" && req.Annotation == "" && req.Image == "" {
+		return models.SearchResponseModel{}, fmt.Errorf(
+			"%w: at least one of query, label_selector, annotation and image is required",
+			ErrInvalidRequest,
+		)
+	}
+
+	var selector labels.Selector
+	if req.LabelSelector != "" {
+		parsed, err := labels.Parse(req.LabelSelector)
+		if err != nil {
+			return models.SearchResponseModel{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		}
+		selector = parsed
+	}
+
+	kinds := map[string]bool{}
+	for _, kind := range strings.Split(req.Kinds, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			kinds[strings.ToLower(kind)] = true
+		}
+	}
+
+	rc := cluster.shared
+	if !rc.HasSynced() {
+		return models.SearchResponseModel{}, ErrCacheNotSynced
+	}
+
+	query := strings.ToLower(req.Query)
+	limit := req.Limit
+	if limit == 0 {
+		limit = searchDefaultLimit
+	}
+
+	resp := models.SearchResponseModel{}
+	resp.Hits = []models.SearchHitModel{}
+	for _, searchable := range searchableKinds {
+		if len(kinds) > 0 && !kinds[strings.ToLower(searchable.kind)] {
+			continue
+		}
+		// image search makes sense only for kinds with pods
+		if req.Image != "" && searchable.images == nil {
+			continue
+		}
+
+		namespace := req.Namespace
+		if searchable.clusterScoped {
+			namespace = ""
+		}
+		allowed, err := canList(cluster, searchable.group, searchable.resource, namespace)
+		if err != nil {
+			return models.SearchResponseModel{}, err
+		}
+		if !allowed {
+			continue
+		}
+
+		for _, object := range searchable.informer(rc.Factory).GetStore().List() {
+			metadata, err := meta.Accessor(object)
+			if err != nil {
+				continue
+			}
+			if req.Namespace != "" && metadata.GetNamespace() != req.Namespace {
+				continue
+			}
+
+			matches := []string{}
+			if query != "" {
+				if !strings.Contains(strings.ToLower(metadata.GetName()), query) {
+					continue
+				}
+				matches = append(matches, SearchMatchName)
+			}
+			if selector != nil {
+				if !selector.Matches(labels.Set(metadata.GetLabels())) {
+					continue
+				}
+				matches = append(matches, SearchMatchLabel)
+			}
+			if req.Annotation != "" {
+				if !matchAnnotation(metadata.GetAnnotations(), req.Annotation) {
+					continue
+				}
+				matches = append(matches, SearchMatchAnnotation)
+			}
+
+			images := []string{}
+			if searchable.images != nil {
+				images = searchable.images(object)
+			}
+			if req.Image != "" {
+				found := false
+				for _, image := range images {
+					if strings.Contains(image, req.Image) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					continue
+				}
+				matches = append(matches, SearchMatchImage)
+			}
+
+			resp.Hits = append(resp.Hits, models.SearchHitModel{
+				Kind:      searchable.kind,
+				Name:      metadata.GetName(),
+				Namespace: metadata.GetNamespace(),
+				Labels:    metadata.GetLabels(),
+				Images:    images,
+				Matches:   matches,
+			})
+		}
+	}
+
+	sort.Slice(resp.Hits, func(i, j int) bool {
+		if resp.Hits[i].Kind != resp.Hits[j].Kind {
+			return resp.Hits[i].Kind < resp.Hits[j].Kind
+		}
+		if resp.Hits[i].Namespace != resp.Hits[j].Namespace {
+			return resp.Hits[i].Namespace < resp.Hits[j].Namespace
+		}
+		return resp.Hits[i].Name < resp.Hits[j].Name
+	})
+
+	resp.Total = len(resp.Hits)
+	if len(resp.Hits) > limit {
+		resp.Hits = resp.Hits[:limit]
+	}
+
+	return resp, nil
+
+}
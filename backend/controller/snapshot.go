@@ -0,0 +1,468 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kube-dash/kube-dash-backend/common"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+var namespacesResource = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// objects others depend on are restored first, kinds not listed go last
+var restoreKindOrder = []string{
+	"ResourceQuota", "LimitRange", "ServiceAccount", "Secret", "ConfigMap",
+	"PersistentVolumeClaim", "Role", "RoleBinding", "Service",
+}
+
+func restoreKindPriority(kind string) int {
+
+	for i, current := range restoreKindOrder {
+		if current == kind {
+			return i
+		}
+	}
+
+	return len(restoreKindOrder)
+}
+
+func snapshotToModel(snapshot *models.DBSnapshotModel, objectCount int) models.SnapshotModel {
+
+	return models.SnapshotModel{
+		ID:          snapshot.ID,
+		Cluster:     snapshot.Cluster,
+		Namespace:   snapshot.Namespace,
+		Label:       snapshot.Label,
+		CreatedAt:   snapshot.CreatedAt.UTC().Format(time.RFC3339),
+		ObjectCount: objectCount,
+	}
+}
+
+// snapshots hold every user created object of the namespace including its
+// secrets, they're readable by users who can get secrets of the namespace
+func canReadSnapshots(cluster *Cluster, namespace string) (bool, error) {
+	return canAccess(cluster, "get", "", "secrets", "", namespace, "")
+}
+
+// snapshot of the cluster readable by the user, snapshots of other
+// clusters are not found, objects are loaded when withObjects is set
+func getSnapshot(
+	cluster *Cluster, db *gorm.DB, id uint, withObjects bool,
+) (*models.DBSnapshotModel, error) {
+
+	query := db.Where("cluster = ?", cluster.Name)
+	if withObjects {
+		query = query.Preload("Objects")
+	}
+
+	snapshot := &models.DBSnapshotModel{}
+	err := query.First(snapshot, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: snapshot %d not found", ErrInvalidRequest, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := canReadSnapshots(cluster, snapshot.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf(
+			"%w: user can't get secrets in namespace %s", ErrForbidden, snapshot.Namespace,
+		)
+	}
+
+	return snapshot, nil
+}
+
+// decode (and decrypt) objects of the snapshot, namespace is set to
+// the snapshot namespace
+func snapshotObjects(snapshot *models.DBSnapshotModel) ([]*unstructured.Unstructured, error) {
+
+	objects := []*unstructured.Unstructured{}
+	for _, snapshotObject := range snapshot.Objects {
+		manifest := snapshotObject.Manifest
+		if snapshotObject.Encrypted {
+			decrypted, err := common.Decrypt(manifest)
+			if err != nil {
+				return nil, err
+			}
+			manifest = decrypted
+		}
+
+		object := &unstructured.Unstructured{}
+		err := json.Unmarshal(manifest, &object.Object)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to decode %s %s from snapshot: %v",
+				snapshotObject.Kind, snapshotObject.Name, err,
+			)
+		}
+		object.SetNamespace(snapshot.Namespace)
+		objects = append(objects, object)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return restoreKindPriority(objects[i].GetKind()) < restoreKindPriority(objects[j].GetKind())
+	})
+
+	return objects, nil
+}
+
+func (dc *DynamicClient) namespaceExists(namespace string) (bool, error) {
+
+	_, err := dc.Client.Resource(namespacesResource).Get(
+		context.TODO(), namespace, metaapiv1.GetOptions{},
+	)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// store all user created objects of the namespace, secrets are encrypted
+// with the server secret key, ErrForbidden when the user couldn't read the
+// snapshot (kinds the user can't list would be silently left out of it)
+func CreateSnapshot(
+	cluster *Cluster,
+	db *gorm.DB,
+	req *models.CreateSnapshotRequestModel,
+) (models.SnapshotModel, error) {
+
+	dc := cluster.Dynamic
+
+	allowed, err := canReadSnapshots(cluster, req.Namespace)
+	if err != nil {
+		return models.SnapshotModel{}, err
+	}
+	if !allowed {
+		return models.SnapshotModel{}, fmt.Errorf(
+			"%w: user can't get secrets in namespace %s", ErrForbidden, req.Namespace,
+		)
+	}
+
+	exists, err := dc.namespaceExists(req.Namespace)
+	if err != nil {
+		return models.SnapshotModel{}, err
+	}
+	if !exists {
+		return models.SnapshotModel{}, fmt.Errorf(
+			"%w: namespace %s not found", ErrInvalidRequest, req.Namespace,
+		)
+	}
+
+	objects, err := dc.listNamespaceObjects(req.Namespace)
+	if err != nil {
+		return models.SnapshotModel{}, err
+	}
+
+	snapshot := models.DBSnapshotModel{
+		Cluster:   cluster.Name,
+		Namespace: req.Namespace,
+		Label:     req.Label,
+	}
+	snapshot.Objects = []models.DBSnapshotObjectModel{}
+	for _, object := range objects {
+		manifest, err := json.Marshal(cleanObject(object).Object)
+		if err != nil {
+			return models.SnapshotModel{}, err
+		}
+
+		encrypted := object.GetKind() == "Secret" && object.GroupVersionKind().Group == ""
+		if encrypted {
+			manifest, err = common.Encrypt(manifest)
+			if err != nil {
+				return models.SnapshotModel{}, err
+			}
+		}
+
+		snapshot.Objects = append(snapshot.Objects, models.DBSnapshotObjectModel{
+			APIVersion: object.GetAPIVersion(),
+			Kind:       object.GetKind(),
+			Name:       object.GetName(),
+			Manifest:   manifest,
+			Encrypted:  encrypted,
+		})
+	}
+
+	// snapshot and its objects are created in one transaction
+	err = db.Create(&snapshot).Error
+	if err != nil {
+		return models.SnapshotModel{}, err
+	}
+
+	return snapshotToModel(&snapshot, len(snapshot.Objects)), nil
+
+}
+
+// snapshots of namespaces the user can't read are left out, ErrForbidden
+// when the requested namespace can't be read
+func ListSnapshots(
+	cluster *Cluster,
+	db *gorm.DB,
+	req *models.ListSnapshotsRequestModel,
+) (models.ListSnapshotsResponseModel, error) {
+
+	readable := map[string]bool{}
+	query := db.Where("cluster = ?", cluster.Name).Order("created_at DESC")
+	if req.Namespace != "" {
+		allowed, err := canReadSnapshots(cluster, req.Namespace)
+		if err != nil {
+			return models.ListSnapshotsResponseModel{}, err
+		}
+		if !allowed {
+			return models.ListSnapshotsResponseModel{}, fmt.Errorf(
+				"%w: user can't get secrets in namespace %s", ErrForbidden, req.Namespace,
+			)
+		}
+		readable[req.Namespace] = true
+		query = query.Where("namespace = ?", req.Namespace)
+	}
+
+	var snapshots []models.DBSnapshotModel
+	err := query.Find(&snapshots).Error
+	if err != nil {
+		return models.ListSnapshotsResponseModel{}, err
+	}
+
+	// count objects without loading the manifests
+	var counts []struct {
+		SnapshotID uint
+		Count      int
+	}
+	err = db.Model(&models.DBSnapshotObjectModel{}).
+		Select("snapshot_id, count(*) as count").
+		Group("snapshot_id").
+		Scan(&counts).Error
+	if err != nil {
+		return models.ListSnapshotsResponseModel{}, err
+	}
+	objectCounts := map[uint]int{}
+	for _, count := range counts {
+		objectCounts[count.SnapshotID] = count.Count
+	}
+
+	resp := models.ListSnapshotsResponseModel{}
+	resp.Snapshots = []models.SnapshotModel{}
+	for i := range snapshots {
+		namespace := snapshots[i].Namespace
+		allowed, checked := readable[namespace]
+		if !checked {
+			allowed, err = canReadSnapshots(cluster, namespace)
+			if err != nil {
+				return models.ListSnapshotsResponseModel{}, err
+			}
+			readable[namespace] = allowed
+		}
+		if !allowed {
+			continue
+		}
+
+		resp.Snapshots = append(
+			resp.Snapshots, snapshotToModel(&snapshots[i], objectCounts[snapshots[i].ID]),
+		)
+	}
+
+	return resp, nil
+
+}
+
+// compare the snapshot with the live state of the namespace, changes
+// are computed as server-side dry run of the restore
+func DiffSnapshot(
+	cluster *Cluster,
+	db *gorm.DB,
+	req *models.DiffSnapshotRequestModel,
+) (models.DiffSnapshotResponseModel, error) {
+
+	dc := cluster.Dynamic
+	snapshot, err := getSnapshot(cluster, db, req.ID, true)
+	if err != nil {
+		return models.DiffSnapshotResponseModel{}, err
+	}
+	objects, err := snapshotObjects(snapshot)
+	if err != nil {
+		return models.DiffSnapshotResponseModel{}, err
+	}
+
+	resp := models.DiffSnapshotResponseModel{Snapshot: snapshotToModel(snapshot, len(objects))}
+	resp.Objects = []models.DiffModel{}
+	resp.NotInSnapshot = []models.SnapshotObjectRefModel{}
+
+	exists, err := dc.namespaceExists(snapshot.Namespace)
+	if err != nil {
+		return models.DiffSnapshotResponseModel{}, err
+	}
+
+	// whole namespace is gone, everything would be created
+	if !exists {
+		for _, object := range objects {
+			diff, err := diffObjects(object.GetKind(), nil, object)
+			if err != nil {
+				return models.DiffSnapshotResponseModel{}, err
+			}
+			resp.Objects = append(resp.Objects, diff)
+		}
+		return resp, nil
+	}
+
+	inSnapshot := map[schema.GroupKind]map[string]bool{}
+	for _, object := range objects {
+		groupKind := object.GroupVersionKind().GroupKind()
+		if inSnapshot[groupKind] == nil {
+			inSnapshot[groupKind] = map[string]bool{}
+		}
+		inSnapshot[groupKind][object.GetName()] = true
+
+		live, applied, err := dc.applyObject(object, snapshot.Namespace, true, true)
+		if err != nil {
+			return models.DiffSnapshotResponseModel{}, fmt.Errorf(
+				"unable to preview %s %s: %v", object.GetKind(), object.GetName(), err,
+			)
+		}
+
+		diff, err := diffObjects(object.GetKind(), live, applied)
+		if err != nil {
+			return models.DiffSnapshotResponseModel{}, err
+		}
+		if len(diff.Changes) > 0 {
+			resp.Objects = append(resp.Objects, diff)
+		}
+	}
+
+	liveObjects, err := dc.listNamespaceObjects(snapshot.Namespace)
+	if err != nil {
+		return models.DiffSnapshotResponseModel{}, err
+	}
+	for _, object := range liveObjects {
+		if !inSnapshot[object.GroupVersionKind().GroupKind()][object.GetName()] {
+			resp.NotInSnapshot = append(resp.NotInSnapshot, models.SnapshotObjectRefModel{
+				Kind: object.GetKind(),
+				Name: object.GetName(),
+			})
+		}
+	}
+
+	return resp, nil
+
+}
+
+// server-side apply every object of the snapshot, conflicts with other
+// field managers are forced so the snapshot state wins, objects created
+// after the snapshot are not removed
+func RestoreSnapshot(
+	cluster *Cluster,
+	db *gorm.DB,
+	req *models.RestoreSnapshotRequestModel,
+) (models.ApplyManifestsResponseModel, error) {
+
+	dc := cluster.Dynamic
+	snapshot, err := getSnapshot(cluster, db, req.ID, true)
+	if err != nil {
+		return models.ApplyManifestsResponseModel{}, err
+	}
+	objects, err := snapshotObjects(snapshot)
+	if err != nil {
+		return models.ApplyManifestsResponseModel{}, err
+	}
+	dryRun := req.DryRun == "server"
+
+	exists, err := dc.namespaceExists(snapshot.Namespace)
+	if err != nil {
+		return models.ApplyManifestsResponseModel{}, err
+	}
+	if !exists {
+		namespace := &unstructured.Unstructured{}
+		namespace.SetAPIVersion("v1")
+		namespace.SetKind("Namespace")
+		namespace.SetName(snapshot.Namespace)
+		options := metaapiv1.CreateOptions{}
+		if dryRun {
+			options.DryRun = []string{metaapiv1.DryRunAll}
+		}
+		_, err = dc.Client.Resource(namespacesResource).Create(context.TODO(), namespace, options)
+		if err != nil {
+			return models.ApplyManifestsResponseModel{}, err
+		}
+	}
+
+	resp := models.ApplyManifestsResponseModel{DryRun: dryRun}
+	resp.Objects = []models.ApplyManifestsResponseModelObject{}
+
+	// failure of one object doesn't stop the others
+	for _, object := range objects {
+		currentObject := models.ApplyManifestsResponseModelObject{
+			APIVersion: object.GetAPIVersion(),
+			Kind:       object.GetKind(),
+			Name:       object.GetName(),
+			Namespace:  snapshot.Namespace,
+		}
+
+		// objects of dry run created namespace can't be dry run applied
+		if dryRun && !exists {
+			currentObject.Result = ApplyResultCreated
+			resp.Objects = append(resp.Objects, currentObject)
+			continue
+		}
+
+		live, applied, err := dc.applyObject(object, snapshot.Namespace, dryRun, true)
+		if err != nil {
+			currentObject.Result = ApplyResultError
+			currentObject.Error = err.Error()
+		} else {
+			currentObject.Result = applyResult(live, applied)
+		}
+
+		resp.Objects = append(resp.Objects, currentObject)
+	}
+
+	return resp, nil
+
+}
+
+func DeleteSnapshot(
+	cluster *Cluster,
+	db *gorm.DB,
+	req *models.DeleteSnapshotRequestModel,
+) error {
+
+	_, err := getSnapshot(cluster, db, req.ID, false)
+	if err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+
+		result := tx.Unscoped().
+			Where("cluster = ?", cluster.Name).
+			Delete(&models.DBSnapshotModel{}, req.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: snapshot %d not found", ErrInvalidRequest, req.ID)
+		}
+
+		return tx.Unscoped().
+			Where("snapshot_id = ?", req.ID).
+			Delete(&models.DBSnapshotObjectModel{}).Error
+	})
+
+}
@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	storageapiv1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+func formatAccessModes(modes []coreapiv1.PersistentVolumeAccessMode) []string {
+
+	accessModes := []string{}
+	for _, mode := range modes {
+		accessModes = append(accessModes, string(mode))
+	}
+
+	return accessModes
+}
+
+func isDefaultStorageClass(class *storageapiv1.StorageClass) bool {
+	return class.Annotations[defaultStorageClassAnnotation] == "true"
+}
+
+func ListPersistentVolumeClaims(
+	clientset kubernetes.Interface,
+	req *models.ListPersistentVolumeClaimsRequestModel,
+) (models.ListPersistentVolumeClaimsResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListPersistentVolumeClaimsResponseModel{}, err
+	}
+	claims, err := clientset.CoreV1().PersistentVolumeClaims(req.Namespace).List(
+		context.TODO(), options,
+	)
+	if err != nil {
+		return models.ListPersistentVolumeClaimsResponseModel{}, listError(err)
+	}
+	err = sortObjects(
+		claims.Items, &req.ListOptionsModel,
+		func(claim *coreapiv1.PersistentVolumeClaim) string {
+			return string(claim.Status.Phase)
+		},
+		nil,
+	)
+	if err != nil {
+		return models.ListPersistentVolumeClaimsResponseModel{}, err
+	}
+
+	// find pods mounting the claims, key is namespace/claim
+	pods, _, err := listPods(clientset, nil, req.Namespace, nil, true)
+	if err != nil {
+		return models.ListPersistentVolumeClaimsResponseModel{}, err
+	}
+	mountedBy := map[string][]string{}
+	for _, pod := range pods {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			key := pod.Namespace + "/" + volume.PersistentVolumeClaim.ClaimName
+			mountedBy[key] = append(mountedBy[key], pod.Name)
+		}
+	}
+
+	resp := models.ListPersistentVolumeClaimsResponseModel{}
+	resp.PersistentVolumeClaims = []models.ListPersistentVolumeClaimsResponseModelClaim{}
+	for _, claimdata := range claims.Items {
+		currentClaim := models.ListPersistentVolumeClaimsResponseModelClaim{}
+		currentClaim.Name = claimdata.Name
+		currentClaim.Namespace = claimdata.Namespace
+		currentClaim.Status = string(claimdata.Status.Phase)
+		currentClaim.Volume = claimdata.Spec.VolumeName
+		currentClaim.Requested = claimdata.Spec.Resources.Requests.Storage().String()
+		currentClaim.Capacity = claimdata.Status.Capacity.Storage().String()
+		currentClaim.AccessModes = formatAccessModes(claimdata.Spec.AccessModes)
+		if claimdata.Spec.StorageClassName != nil {
+			currentClaim.StorageClass = *claimdata.Spec.StorageClassName
+		}
+		currentClaim.MountedBy = mountedBy[claimdata.Namespace+"/"+claimdata.Name]
+		if currentClaim.MountedBy == nil {
+			currentClaim.MountedBy = []string{}
+		}
+		currentClaim.CreationTime =
+			claimdata.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+		resp.PersistentVolumeClaims = append(resp.PersistentVolumeClaims, currentClaim)
+	}
+	resp.Pagination = pagination(claims.ListMeta, len(resp.PersistentVolumeClaims))
+
+	return resp, nil
+
+}
+
+func ListPersistentVolumes(
+	clientset kubernetes.Interface,
+	req *models.ListPersistentVolumesRequestModel,
+) (models.ListPersistentVolumesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListPersistentVolumesResponseModel{}, err
+	}
+	volumes, err := clientset.CoreV1().PersistentVolumes().List(context.TODO(), options)
+	if err != nil {
+		return models.ListPersistentVolumesResponseModel{}, listError(err)
+	}
+	err = sortObjects(
+		volumes.Items, &req.ListOptionsModel,
+		func(volume *coreapiv1.PersistentVolume) string {
+			return string(volume.Status.Phase)
+		},
+		nil,
+	)
+	if err != nil {
+		return models.ListPersistentVolumesResponseModel{}, err
+	}
+
+	resp := models.ListPersistentVolumesResponseModel{}
+	resp.PersistentVolumes = []models.ListPersistentVolumesResponseModelVolume{}
+	for _, volumedata := range volumes.Items {
+		currentVolume := models.ListPersistentVolumesResponseModelVolume{}
+		currentVolume.Name = volumedata.Name
+		currentVolume.Status = string(volumedata.Status.Phase)
+		currentVolume.Capacity = volumedata.Spec.Capacity.Storage().String()
+		currentVolume.AccessModes = formatAccessModes(volumedata.Spec.AccessModes)
+		currentVolume.ReclaimPolicy = string(volumedata.Spec.PersistentVolumeReclaimPolicy)
+		currentVolume.StorageClass = volumedata.Spec.StorageClassName
+		if volumedata.Spec.ClaimRef != nil {
+			currentVolume.Claim =
+				volumedata.Spec.ClaimRef.Namespace + "/" + volumedata.Spec.ClaimRef.Name
+		}
+		currentVolume.CreationTime =
+			volumedata.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+		resp.PersistentVolumes = append(resp.PersistentVolumes, currentVolume)
+	}
+	resp.Pagination = pagination(volumes.ListMeta, len(resp.PersistentVolumes))
+
+	return resp, nil
+
+}
+
+func ListStorageClasses(
+	clientset kubernetes.Interface,
+	req *models.ListStorageClassesRequestModel,
+) (models.ListStorageClassesResponseModel, error) {
+
+	options, err := listOptions(&req.ListOptionsModel)
+	if err != nil {
+		return models.ListStorageClassesResponseModel{}, err
+	}
+	classes, err := clientset.StorageV1().StorageClasses().List(context.TODO(), options)
+	if err != nil {
+		return models.ListStorageClassesResponseModel{}, listError(err)
+	}
+	err = sortObjects(classes.Items, &req.ListOptionsModel, nil, nil)
+	if err != nil {
+		return models.ListStorageClassesResponseModel{}, err
+	}
+
+	resp := models.ListStorageClassesResponseModel{}
+	resp.StorageClasses = []models.ListStorageClassesResponseModelClass{}
+	for i := range classes.Items {
+		classdata := &classes.Items[i]
+		currentClass := models.ListStorageClassesResponseModelClass{}
+		currentClass.Name = classdata.Name
+		currentClass.Provisioner = classdata.Provisioner
+		if classdata.ReclaimPolicy != nil {
+			currentClass.ReclaimPolicy = string(*classdata.ReclaimPolicy)
+		}
+		if classdata.VolumeBindingMode != nil {
+			currentClass.VolumeBindingMode = string(*classdata.VolumeBindingMode)
+		}
+		if classdata.AllowVolumeExpansion != nil {
+			currentClass.AllowVolumeExpansion = *classdata.AllowVolumeExpansion
+		}
+		currentClass.Default = isDefaultStorageClass(classdata)
+		resp.StorageClasses = append(resp.StorageClasses, currentClass)
+	}
+	resp.Pagination = pagination(classes.ListMeta, len(resp.StorageClasses))
+
+	return resp, nil
+
+}
+
+// class the claim's volume was provisioned with, claims without the field
+// got the default class of that time which may not be the current one, so
+// the class is taken from the bound volume
+func claimStorageClassName(
+	clientset kubernetes.Interface, claim *coreapiv1.PersistentVolumeClaim,
+) (string, error) {
+
+	if claim.Spec.StorageClassName != nil {
+		return *claim.Spec.StorageClassName, nil
+	}
+	if claim.Spec.VolumeName == "" {
+		return "", fmt.Errorf(
+			"%w: claim has no storage class and isn't bound to a volume",
+			ErrInvalidRequest,
+		)
+	}
+
+	volume, err := clientset.CoreV1().PersistentVolumes().Get(
+		context.TODO(), claim.Spec.VolumeName, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return volume.Spec.StorageClassName, nil
+}
+
+func CreatePersistentVolumeClaim(
+	clientset kubernetes.Interface,
+	req *models.CreatePersistentVolumeClaimRequestModel,
+) error {
+
+	size, err := resource.ParseQuantity(req.Size)
+	if err != nil {
+		return fmt.Errorf("%w: invalid size %q", ErrInvalidRequest, req.Size)
+	}
+
+	accessModes := []coreapiv1.PersistentVolumeAccessMode{}
+	for _, mode := range req.AccessModes {
+		accessModes = append(accessModes, coreapiv1.PersistentVolumeAccessMode(mode))
+	}
+	if len(accessModes) == 0 {
+		accessModes = append(accessModes, coreapiv1.ReadWriteOnce)
+	}
+
+	claim := &coreapiv1.PersistentVolumeClaim{
+		ObjectMeta: metaapiv1.ObjectMeta{
+			Name: req.Name,
+		},
+		Spec: coreapiv1.PersistentVolumeClaimSpec{
+			AccessModes: accessModes,
+			Resources: coreapiv1.VolumeResourceRequirements{
+				Requests: coreapiv1.ResourceList{
+					coreapiv1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+	// nil means cluster default, empty string would mean no class at all
+	if req.StorageClass != "" {
+		claim.Spec.StorageClassName = &req.StorageClass
+	}
+
+	_, err = clientset.CoreV1().PersistentVolumeClaims(req.Namespace).Create(
+		context.TODO(), claim, metaapiv1.CreateOptions{},
+	)
+	return err
+
+}
+
+func ExpandPersistentVolumeClaim(
+	clientset kubernetes.Interface,
+	req *models.ExpandPersistentVolumeClaimRequestModel,
+) error {
+
+	size, err := resource.ParseQuantity(req.Size)
+	if err != nil {
+		return fmt.Errorf("%w: invalid size %q", ErrInvalidRequest, req.Size)
+	}
+
+	claim, err := clientset.CoreV1().PersistentVolumeClaims(req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+
+	current := claim.Spec.Resources.Requests.Storage()
+	if size.Cmp(*current) <= 0 {
+		return fmt.Errorf(
+			"%w: new size %s has to be bigger than current %s, claims can't shrink",
+			ErrInvalidRequest, size.String(), current.String(),
+		)
+	}
+
+	// the empty class is statically bound to a volume without any class
+	className, err := claimStorageClassName(clientset, claim)
+	if err != nil {
+		return err
+	}
+	if className == "" {
+		return fmt.Errorf(
+			"%w: claim is bound without storage class, it can't be expanded",
+			ErrInvalidRequest,
+		)
+	}
+	class, err := clientset.StorageV1().StorageClasses().Get(
+		context.TODO(), className, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if class.AllowVolumeExpansion == nil || !*class.AllowVolumeExpansion {
+		return fmt.Errorf(
+			"%w: storage class %q doesn't allow volume expansion",
+			ErrInvalidRequest, class.Name,
+		)
+	}
+
+	claim.Spec.Resources.Requests[coreapiv1.ResourceStorage] = size
+
+	_, err = clientset.CoreV1().PersistentVolumeClaims(req.Namespace).Update(
+		context.TODO(), claim, metaapiv1.UpdateOptions{},
+	)
+	return err
+
+}
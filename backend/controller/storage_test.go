@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	storageapiv1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func TestExpandClaimWithoutStorageClass(t *testing.T) {
+
+	allowExpansion := true
+	denyExpansion := false
+	class := func(name string, allow *bool, isDefault bool) *storageapiv1.StorageClass {
+		class := &storageapiv1.StorageClass{
+			ObjectMeta:           metaapiv1.ObjectMeta{Name: name},
+			AllowVolumeExpansion: allow,
+		}
+		if isDefault {
+			class.Annotations = map[string]string{defaultStorageClassAnnotation: "true"}
+		}
+		return class
+	}
+	volume := func(name string, className string) *coreapiv1.PersistentVolume {
+		return &coreapiv1.PersistentVolume{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: name},
+			Spec:       coreapiv1.PersistentVolumeSpec{StorageClassName: className},
+		}
+	}
+	// claims created before the default class was set up or changed
+	claim := func(name string, volumeName string) *coreapiv1.PersistentVolumeClaim {
+		return &coreapiv1.PersistentVolumeClaim{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: coreapiv1.PersistentVolumeClaimSpec{
+				Resources: coreapiv1.VolumeResourceRequirements{
+					Requests: coreapiv1.ResourceList{coreapiv1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+				VolumeName: volumeName,
+			},
+		}
+	}
+
+	clientset := fakekubernetes.NewSimpleClientset([]runtime.Object{
+		class("current", &allowExpansion, true),
+		class("standard", &allowExpansion, false),
+		class("slow", &denyExpansion, false),
+		volume("pv-standard", "standard"),
+		volume("pv-slow", "slow"),
+		volume("pv-static", ""),
+		claim("standard", "pv-standard"),
+		claim("slow", "pv-slow"),
+		claim("static", "pv-static"),
+		claim("unbound", ""),
+	}...)
+
+	tests := []struct {
+		claim   string
+		invalid bool
+	}{
+		{claim: "standard"},
+		// the current default class allows expansion, the volume's one doesn't
+		{claim: "slow", invalid: true},
+		{claim: "static", invalid: true},
+		{claim: "unbound", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.claim, func(t *testing.T) {
+			err := ExpandPersistentVolumeClaim(clientset, &models.ExpandPersistentVolumeClaimRequestModel{
+				Namespace: "default", Name: test.claim, Size: "2Gi",
+			})
+			if test.invalid != errors.Is(err, ErrInvalidRequest) || (!test.invalid && err != nil) {
+				t.Fatalf("expected invalid %v, got %v", test.invalid, err)
+			}
+		})
+	}
+}
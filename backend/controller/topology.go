@@ -0,0 +1,543 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	GraphEdgeOwns       = "owns"
+	GraphEdgeRoutes     = "routes"
+	GraphEdgeSelects    = "selects"
+	GraphEdgeMounts     = "mounts"
+	GraphEdgeReferences = "references"
+)
+
+// status of nodes referenced by other objects which don't exist
+const GraphNodeMissing = "Missing"
+
+// status of nodes referenced by other objects of kinds the user can't list
+const GraphNodeUnknown = "Unknown"
+
+// owner references are followed at most this many levels up
+const maxOwnerDepth = 10
+
+// nodes and edges are deduplicated so objects can be added in any order
+type graphBuilder struct {
+	nodes     []models.GraphNodeModel
+	edges     []models.GraphEdgeModel
+	seenNodes map[string]bool
+	seenEdges map[string]bool
+}
+
+func newGraphBuilder() *graphBuilder {
+
+	return &graphBuilder{
+		nodes:     []models.GraphNodeModel{},
+		edges:     []models.GraphEdgeModel{},
+		seenNodes: map[string]bool{},
+		seenEdges: map[string]bool{},
+	}
+}
+
+func (g *graphBuilder) addNode(node models.GraphNodeModel) {
+
+	if g.seenNodes[node.ID] {
+		return
+	}
+	g.seenNodes[node.ID] = true
+	g.nodes = append(g.nodes, node)
+}
+
+func (g *graphBuilder) addEdge(source string, target string, edgeType string) {
+
+	key := source + "|" + target + "|" + edgeType
+	if g.seenEdges[key] {
+		return
+	}
+	g.seenEdges[key] = true
+	g.edges = append(g.edges, models.GraphEdgeModel{
+		Source: source, Target: target, Type: edgeType,
+	})
+}
+
+// whether any edge leads to the node
+func (g *graphBuilder) usedAsTarget(id string) bool {
+
+	for _, edge := range g.edges {
+		if edge.Target == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func graphNode(
+	metadata metaapiv1.Object, apiVersion string, kind string, status string,
+) models.GraphNodeModel {
+
+	return models.GraphNodeModel{
+		ID:         string(metadata.GetUID()),
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       metadata.GetName(),
+		Namespace:  metadata.GetNamespace(),
+		Status:     status,
+	}
+}
+
+// node for object which is referenced but doesn't exist, or which may
+// exist when the objects of its kind (existing IDs) couldn't be listed
+func missingGraphNode(
+	existing map[string]string, apiVersion string, kind string, namespace string, name string,
+) models.GraphNodeModel {
+
+	status := GraphNodeMissing
+	if existing == nil {
+		status = GraphNodeUnknown
+	}
+
+	return models.GraphNodeModel{
+		ID:         fmt.Sprintf("missing/%s/%s", kind, name),
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       name,
+		Namespace:  namespace,
+		Status:     status,
+	}
+}
+
+// short status of any object, phase (pods, claims) or ready replicas (workloads)
+func unstructuredStatus(object *unstructured.Unstructured) string {
+
+	phase, found, _ := unstructured.NestedString(object.Object, "status", "phase")
+	if found {
+		return phase
+	}
+
+	replicas, found, _ := unstructured.NestedInt64(object.Object, "spec", "replicas")
+	if found {
+		ready, _, _ := unstructured.NestedInt64(object.Object, "status", "readyReplicas")
+		return fmt.Sprintf("%d/%d ready", ready, replicas)
+	}
+
+	return ""
+}
+
+func replicasStatus(ready int32, replicas *int32) string {
+
+	desired := int32(1)
+	if replicas != nil {
+		desired = *replicas
+	}
+
+	return fmt.Sprintf("%d/%d ready", ready, desired)
+}
+
+// follow controller owner references up to the top level owner,
+// owners which can't be read end the walk
+func (dc *DynamicClient) topOwner(object *unstructured.Unstructured) *unstructured.Unstructured {
+
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		owner := metaapiv1.GetControllerOfNoCopy(object)
+		if owner == nil {
+			break
+		}
+
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil {
+			break
+		}
+		mapping, err := dc.Mapper.RESTMapping(gv.WithKind(owner.Kind).GroupKind(), gv.Version)
+		if err != nil {
+			break
+		}
+
+		parent, err := dc.resourceInterface(mapping, object.GetNamespace()).Get(
+			context.TODO(), owner.Name, metaapiv1.GetOptions{},
+		)
+		if err != nil || parent.GetUID() != owner.UID {
+			break
+		}
+		object = parent
+	}
+
+	return object
+}
+
+// ownership tree of the top level owner of the object (ex. Deployment ->
+// ReplicaSets -> Pods when asked for a pod), dependents of cluster scoped
+// objects are searched only among cluster scoped objects
+func GetResourceTree(
+	dc *DynamicClient,
+	req *models.GetResourceTreeRequestModel,
+) (models.ResourceTreeResponseModel, error) {
+
+	mapping, err := dc.resolveResource(req.Group, req.Version, req.Resource)
+	if err != nil {
+		return models.ResourceTreeResponseModel{}, err
+	}
+	err = checkNamespaceGiven(mapping, req.Namespace)
+	if err != nil {
+		return models.ResourceTreeResponseModel{}, err
+	}
+
+	object, err := dc.resourceInterface(mapping, req.Namespace).Get(
+		context.TODO(), req.Name, metaapiv1.GetOptions{},
+	)
+	if err != nil {
+		return models.ResourceTreeResponseModel{}, err
+	}
+	root := dc.topOwner(object)
+
+	// events reference objects but never own them
+	candidates, err := dc.listObjects(root.GetNamespace(), func(gvr schema.GroupVersionResource, verbs []string) bool {
+		return gvr.Resource != "events"
+	})
+	if err != nil {
+		return models.ResourceTreeResponseModel{}, err
+	}
+
+	dependents := map[types.UID][]*unstructured.Unstructured{}
+	for _, candidate := range candidates {
+		for _, owner := range candidate.GetOwnerReferences() {
+			dependents[owner.UID] = append(dependents[owner.UID], candidate)
+		}
+	}
+
+	graph := newGraphBuilder()
+	graph.addNode(graphNode(root, root.GetAPIVersion(), root.GetKind(), unstructuredStatus(root)))
+
+	queue := []*unstructured.Unstructured{root}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dependent := range dependents[current.GetUID()] {
+			if !graph.seenNodes[string(dependent.GetUID())] {
+				graph.addNode(graphNode(
+					dependent, dependent.GetAPIVersion(), dependent.GetKind(),
+					unstructuredStatus(dependent),
+				))
+				queue = append(queue, dependent)
+			}
+			graph.addEdge(string(current.GetUID()), string(dependent.GetUID()), GraphEdgeOwns)
+		}
+	}
+
+	return models.ResourceTreeResponseModel{
+		Root:   string(root.GetUID()),
+		Target: string(object.GetUID()),
+		Nodes:  graph.nodes,
+		Edges:  graph.edges,
+	}, nil
+
+}
+
+// add edges from the pod to config maps, secrets and claims it uses,
+// objects which don't exist are added as missing nodes (unknown when
+// their kind couldn't be listed)
+func addPodReferences(
+	graph *graphBuilder, pod *coreapiv1.Pod,
+	configMaps map[string]string, secrets map[string]string, claims map[string]string,
+) {
+
+	podID := string(pod.UID)
+	link := func(existing map[string]string, kind string, name string, edgeType string) {
+		target, found := existing[name]
+		if !found {
+			node := missingGraphNode(existing, "v1", kind, pod.Namespace, name)
+			graph.addNode(node)
+			target = node.ID
+		}
+		graph.addEdge(podID, target, edgeType)
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil:
+			link(configMaps, "ConfigMap", volume.ConfigMap.Name, GraphEdgeMounts)
+		case volume.Secret != nil:
+			link(secrets, "Secret", volume.Secret.SecretName, GraphEdgeMounts)
+		case volume.PersistentVolumeClaim != nil:
+			link(claims, "PersistentVolumeClaim", volume.PersistentVolumeClaim.ClaimName, GraphEdgeMounts)
+		case volume.Projected != nil:
+			// service account token volume is injected into every pod
+			if slices.ContainsFunc(volume.Projected.Sources, func(source coreapiv1.VolumeProjection) bool {
+				return source.ServiceAccountToken != nil
+			}) {
+				continue
+			}
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					link(configMaps, "ConfigMap", source.ConfigMap.Name, GraphEdgeMounts)
+				}
+				if source.Secret != nil {
+					link(secrets, "Secret", source.Secret.Name, GraphEdgeMounts)
+				}
+			}
+		}
+	}
+
+	containers := append([]coreapiv1.Container{}, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				link(configMaps, "ConfigMap", envFrom.ConfigMapRef.Name, GraphEdgeReferences)
+			}
+			if envFrom.SecretRef != nil {
+				link(secrets, "Secret", envFrom.SecretRef.Name, GraphEdgeReferences)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				link(configMaps, "ConfigMap", env.ValueFrom.ConfigMapKeyRef.Name, GraphEdgeReferences)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				link(secrets, "Secret", env.ValueFrom.SecretKeyRef.Name, GraphEdgeReferences)
+			}
+		}
+	}
+
+	for _, pullSecret := range pod.Spec.ImagePullSecrets {
+		link(secrets, "Secret", pullSecret.Name, GraphEdgeReferences)
+	}
+}
+
+// list of the kind, empty when the user can't list it so the graph shows
+// what the user can see, readable tells which of both it is
+func listReadable[T any](list *T, err error) (*T, bool, error) {
+
+	if apierrors.IsForbidden(err) {
+		return new(T), false, nil
+	}
+
+	return list, true, err
+}
+
+// IDs of the objects by name, nil when the kind isn't readable so
+// references to it aren't taken for missing objects
+func objectIDs[T any, PT interface {
+	*T
+	metaapiv1.Object
+}](readable bool, items []T) map[string]string {
+
+	if !readable {
+		return nil
+	}
+	ids := map[string]string{}
+	for i := range items {
+		object := PT(&items[i])
+		ids[object.GetName()] = string(object.GetUID())
+	}
+
+	return ids
+}
+
+// graph of the namespace: ingresses route to services, services select pods,
+// workloads own pods and pods use config maps, secrets and claims, kinds the
+// user can't list are left out, config maps and secrets are listed without
+// their data
+func GetTopology(
+	clientset kubernetes.Interface,
+	metadataclient metadata.Interface,
+	req *models.GetTopologyRequestModel,
+) (models.TopologyResponseModel, error) {
+
+	ctx := context.TODO()
+	listOptions := metaapiv1.ListOptions{}
+	namespace := req.Namespace
+
+	ingresses, _, err := listReadable(clientset.NetworkingV1().Ingresses(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	services, servicesReadable, err := listReadable(clientset.CoreV1().Services(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	pods, _, err := listReadable(clientset.CoreV1().Pods(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	deployments, _, err := listReadable(clientset.AppsV1().Deployments(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	replicaSets, _, err := listReadable(clientset.AppsV1().ReplicaSets(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	statefulSets, _, err := listReadable(clientset.AppsV1().StatefulSets(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	daemonSets, _, err := listReadable(clientset.AppsV1().DaemonSets(namespace).List(ctx, listOptions))
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	configMaps, configMapsReadable, err := listReadable(
+		metadataclient.Resource(coreapiv1.SchemeGroupVersion.WithResource("configmaps")).
+			Namespace(namespace).
+			List(ctx, listOptions),
+	)
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	secrets, secretsReadable, err := listReadable(
+		metadataclient.Resource(coreapiv1.SchemeGroupVersion.WithResource("secrets")).
+			Namespace(namespace).
+			List(ctx, listOptions),
+	)
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+	claims, claimsReadable, err := listReadable(
+		clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, listOptions),
+	)
+	if err != nil {
+		return models.TopologyResponseModel{}, err
+	}
+
+	graph := newGraphBuilder()
+
+	// workloads, only replica sets of deployments are shown
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		graph.addNode(graphNode(
+			deployment, "apps/v1", "Deployment",
+			replicasStatus(deployment.Status.ReadyReplicas, deployment.Spec.Replicas),
+		))
+	}
+	for i := range replicaSets.Items {
+		replicaSet := &replicaSets.Items[i]
+		owner := metaapiv1.GetControllerOfNoCopy(replicaSet)
+		if owner == nil || !graph.seenNodes[string(owner.UID)] {
+			continue
+		}
+		// old revisions scaled to zero would only clutter the graph
+		if replicaSet.Spec.Replicas != nil && *replicaSet.Spec.Replicas == 0 {
+			continue
+		}
+		graph.addNode(graphNode(
+			replicaSet, "apps/v1", "ReplicaSet",
+			replicasStatus(replicaSet.Status.ReadyReplicas, replicaSet.Spec.Replicas),
+		))
+		graph.addEdge(string(owner.UID), string(replicaSet.UID), GraphEdgeOwns)
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		graph.addNode(graphNode(
+			statefulSet, "apps/v1", "StatefulSet",
+			replicasStatus(statefulSet.Status.ReadyReplicas, statefulSet.Spec.Replicas),
+		))
+	}
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		graph.addNode(graphNode(
+			daemonSet, "apps/v1", "DaemonSet",
+			fmt.Sprintf("%d/%d ready", daemonSet.Status.NumberReady, daemonSet.Status.DesiredNumberScheduled),
+		))
+	}
+
+	configMapIDs := objectIDs(configMapsReadable, configMaps.Items)
+	secretIDs := objectIDs(secretsReadable, secrets.Items)
+	claimIDs := objectIDs(claimsReadable, claims.Items)
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		graph.addNode(graphNode(pod, "v1", "Pod", string(pod.Status.Phase)))
+
+		owner := metaapiv1.GetControllerOfNoCopy(pod)
+		if owner != nil && graph.seenNodes[string(owner.UID)] {
+			graph.addEdge(string(owner.UID), string(pod.UID), GraphEdgeOwns)
+		}
+
+		addPodReferences(graph, pod, configMapIDs, secretIDs, claimIDs)
+	}
+
+	// config objects are added only when something uses them
+	for i := range configMaps.Items {
+		if graph.usedAsTarget(string(configMaps.Items[i].UID)) {
+			graph.addNode(graphNode(&configMaps.Items[i], "v1", "ConfigMap", ""))
+		}
+	}
+	for i := range secrets.Items {
+		if graph.usedAsTarget(string(secrets.Items[i].UID)) {
+			graph.addNode(graphNode(&secrets.Items[i], "v1", "Secret", ""))
+		}
+	}
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if graph.usedAsTarget(string(claim.UID)) {
+			graph.addNode(graphNode(claim, "v1", "PersistentVolumeClaim", string(claim.Status.Phase)))
+		}
+	}
+
+	serviceIDs := objectIDs(servicesReadable, services.Items)
+	for i := range services.Items {
+		service := &services.Items[i]
+		graph.addNode(graphNode(service, "v1", "Service", string(service.Spec.Type)))
+
+		// services without selector have manually managed endpoints
+		if len(service.Spec.Selector) == 0 {
+			continue
+		}
+		selector := labels.SelectorFromSet(service.Spec.Selector)
+		for j := range pods.Items {
+			if selector.Matches(labels.Set(pods.Items[j].Labels)) {
+				graph.addEdge(string(service.UID), string(pods.Items[j].UID), GraphEdgeSelects)
+			}
+		}
+	}
+
+	for i := range ingresses.Items {
+		ingress := &ingresses.Items[i]
+		graph.addNode(graphNode(ingress, "networking.k8s.io/v1", "Ingress", ""))
+
+		backendServices := []string{}
+		if ingress.Spec.DefaultBackend != nil && ingress.Spec.DefaultBackend.Service != nil {
+			backendServices = append(backendServices, ingress.Spec.DefaultBackend.Service.Name)
+		}
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.Service != nil {
+					backendServices = append(backendServices, path.Backend.Service.Name)
+				}
+			}
+		}
+
+		for _, serviceName := range backendServices {
+			target, found := serviceIDs[serviceName]
+			if !found {
+				node := missingGraphNode(serviceIDs, "v1", "Service", namespace, serviceName)
+				graph.addNode(node)
+				target = node.ID
+			}
+			graph.addEdge(string(ingress.UID), target, GraphEdgeRoutes)
+		}
+	}
+
+	return models.TopologyResponseModel{Nodes: graph.nodes, Edges: graph.edges}, nil
+
+}
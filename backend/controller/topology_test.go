@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"testing"
+
+	coreapiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+	fakemetadata "k8s.io/client-go/metadata/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// reactor denying lists of the resource like RBAC of the user would
+func forbidList(group string, resource string) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(
+			schema.GroupResource{Group: group, Resource: resource}, "", nil,
+		)
+	}
+}
+
+func TestTopologyWithoutSecretAccess(t *testing.T) {
+
+	clientset := fakekubernetes.NewSimpleClientset(&coreapiv1.Pod{
+		ObjectMeta: metaapiv1.ObjectMeta{Name: "web", Namespace: "default", UID: "pod-uid"},
+		Spec: coreapiv1.PodSpec{
+			Volumes: []coreapiv1.Volume{
+				{Name: "settings", VolumeSource: coreapiv1.VolumeSource{
+					ConfigMap: &coreapiv1.ConfigMapVolumeSource{
+						LocalObjectReference: coreapiv1.LocalObjectReference{Name: "settings"},
+					},
+				}},
+				{Name: "tls", VolumeSource: coreapiv1.VolumeSource{
+					Secret: &coreapiv1.SecretVolumeSource{SecretName: "tls"},
+				}},
+				{Name: "cache", VolumeSource: coreapiv1.VolumeSource{
+					ConfigMap: &coreapiv1.ConfigMapVolumeSource{
+						LocalObjectReference: coreapiv1.LocalObjectReference{Name: "cache"},
+					},
+				}},
+			},
+		},
+	})
+	clientset.PrependReactor("list", "ingresses", forbidList("networking.k8s.io", "ingresses"))
+
+	metadataScheme := fakemetadata.NewTestScheme()
+	metaapiv1.AddMetaToScheme(metadataScheme)
+	metadataObject := func(kind string, name string, uid string) *metaapiv1.PartialObjectMetadata {
+		return &metaapiv1.PartialObjectMetadata{
+			TypeMeta:   metaapiv1.TypeMeta{APIVersion: "v1", Kind: kind},
+			ObjectMeta: metaapiv1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(uid)},
+		}
+	}
+	metadataclient := fakemetadata.NewSimpleMetadataClient(metadataScheme,
+		metadataObject("ConfigMap", "settings", "configmap-uid"),
+		metadataObject("Secret", "tls", "secret-uid"),
+	)
+	metadataclient.PrependReactor("list", "secrets", forbidList("", "secrets"))
+
+	topology, err := GetTopology(clientset, metadataclient, &models.GetTopologyRequestModel{Namespace: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the secret may exist, the config map which isn't listed doesn't
+	statuses := map[string]string{}
+	for _, node := range topology.Nodes {
+		statuses[node.Kind+"/"+node.Name] = node.Status
+	}
+	expected := map[string]string{
+		"Pod/web":            "",
+		"ConfigMap/settings": "",
+		"ConfigMap/cache":    GraphNodeMissing,
+		"Secret/tls":         GraphNodeUnknown,
+	}
+	if len(statuses) != len(expected) {
+		t.Fatalf("expected nodes %v, got %v", expected, statuses)
+	}
+	for node, status := range expected {
+		if got, found := statuses[node]; !found || got != status {
+			t.Fatalf("expected %s with status %q, got %v", node, status, statuses)
+		}
+	}
+	if len(topology.Edges) != 3 {
+		t.Fatalf("expected edges from the pod to its volumes, got %v", topology.Edges)
+	}
+}
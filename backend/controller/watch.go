@@ -0,0 +1,279 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	appsapiv1 "k8s.io/api/apps/v1"
+	coreapiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+const (
+	WatchEventAdded    = "ADDED"
+	WatchEventModified = "MODIFIED"
+	WatchEventDeleted  = "DELETED"
+)
+
+// number of recent changes kept so interrupted streams can resume
+const watchHistorySize = 4096
+
+// changes buffered for every stream, streams which can't keep up
+// are closed and have to resume
+const watchStreamBuffer = 256
+
+var ErrWatchExpired = errors.New(
+	"resource version is too old or unknown, list again and watch without it",
+)
+
+// resource which changes can be watched, changes are sent
+// with the same summarized models as the list endpoints use
+type watchableResource struct {
+	// API group of the resource for access reviews
+	group    string
+	resource string
+	kind     string
+	informer func(factory informers.SharedInformerFactory) cache.SharedIndexInformer
+	summary  func(object interface{}) interface{}
+}
+
+var watchableResources = []watchableResource{
+	{
+		resource: "pods",
+		kind:     "Pod",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Pods().Informer()
+		},
+		summary: func(object interface{}) interface{} {
+			return podSummary(object.(*coreapiv1.Pod))
+		},
+	},
+	{
+		group:    "apps",
+		resource: "deployments",
+		kind:     "Deployment",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Apps().V1().Deployments().Informer()
+		},
+		summary: func(object interface{}) interface{} {
+			return deploymentSummary(object.(*appsapiv1.Deployment))
+		},
+	},
+	{
+		resource: "services",
+		kind:     "Service",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Services().Informer()
+		},
+		summary: func(object interface{}) interface{} {
+			return serviceSummary(object.(*coreapiv1.Service))
+		},
+	},
+	{
+		resource: "events",
+		kind:     "Event",
+		informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Core().V1().Events().Informer()
+		},
+		summary: func(object interface{}) interface{} {
+			return eventToModel(object.(*coreapiv1.Event))
+		},
+	},
+}
+
+// fan-out of cache changes to streams, every change gets increasing
+// resource version which streams use to resume
+type watchHub struct {
+	mu      sync.Mutex
+	version uint64
+	history []models.WatchEventModel
+	streams map[*WatchStream]bool
+}
+
+type WatchStream struct {
+	// resource version when the stream was opened
+	Version uint64
+	// changes for the stream, closed when the stream was too slow
+	Events    chan models.WatchEventModel
+	kinds     map[string]bool
+	namespace string
+	hub       *watchHub
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{streams: map[*WatchStream]bool{}}
+}
+
+func (s *WatchStream) matches(event *models.WatchEventModel) bool {
+	return s.kinds[event.Kind] && (s.namespace == "" || s.namespace == event.Namespace)
+}
+
+// stop receiving changes, safe to call more than once
+func (s *WatchStream) Close() {
+
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.hub.streams[s] {
+		delete(s.hub.streams, s)
+		close(s.Events)
+	}
+}
+
+func (h *watchHub) publish(resource *watchableResource, eventType string, object interface{}) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.version++
+	event := models.WatchEventModel{
+		ResourceVersion: h.version,
+		Type:            eventType,
+		Kind:            resource.kind,
+		Object:          resource.summary(object),
+	}
+	if metadata, ok := object.(interface{ GetNamespace() string }); ok {
+		event.Namespace = metadata.GetNamespace()
+	}
+
+	h.history = append(h.history, event)
+	if len(h.history) > watchHistorySize {
+		h.history = h.history[len(h.history)-watchHistorySize:]
+	}
+
+	for stream := range h.streams {
+		if !stream.matches(&event) {
+			continue
+		}
+		select {
+		case stream.Events <- event:
+		default:
+			// the stream resumes from its last event after reconnect
+			delete(h.streams, stream)
+			close(stream.Events)
+		}
+	}
+}
+
+// publish changes of the informer, objects from the initial list
+// and periodic resyncs are not changes
+func (h *watchHub) register(resource *watchableResource, informer cache.SharedIndexInformer) {
+
+	_, _ = informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(object interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				h.publish(resource, WatchEventAdded, object)
+			}
+		},
+		UpdateFunc: func(oldObject interface{}, newObject interface{}) {
+			oldMeta, oldOk := oldObject.(interface{ GetResourceVersion() string })
+			newMeta, newOk := newObject.(interface{ GetResourceVersion() string })
+			if oldOk && newOk && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			h.publish(resource, WatchEventModified, newObject)
+		},
+		DeleteFunc: func(object interface{}) {
+			// deletion seen only after relist carries the last known state
+			if tombstone, ok := object.(cache.DeletedFinalStateUnknown); ok {
+				object = tombstone.Obj
+			}
+			h.publish(resource, WatchEventDeleted, object)
+		},
+	})
+}
+
+// open stream of changes of the resources (comma separated, all when empty)
+// in the namespace (all when empty) of the cluster cache, changes after
+// resourceVersion (when not zero) are returned to be sent first,
+// impersonated users can watch only resources they can list
+func Watch(
+	cluster *Cluster,
+	req *models.WatchRequestModel,
+) (*WatchStream, []models.WatchEventModel, error) {
+
+	requested := map[string]bool{}
+	for _, resource := range strings.Split(req.Resources, ",") {
+		resource = strings.ToLower(strings.TrimSpace(resource))
+		if resource == "" {
+			continue
+		}
+		found := false
+		for _, watchable := range watchableResources {
+			if watchable.resource == resource {
+				requested[watchable.resource] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, nil, fmt.Errorf(
+				"%w: resource %s can't be watched", ErrInvalidRequest, resource,
+			)
+		}
+	}
+
+	kinds := map[string]bool{}
+	for _, watchable := range watchableResources {
+		if len(requested) > 0 && !requested[watchable.resource] {
+			continue
+		}
+		allowed, err := canList(cluster, watchable.group, watchable.resource, req.Namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !allowed {
+			if len(requested) > 0 {
+				return nil, nil, fmt.Errorf(
+					"%w: user can't list %s", ErrForbidden, watchable.resource,
+				)
+			}
+			continue
+		}
+		kinds[watchable.kind] = true
+	}
+	if len(kinds) == 0 {
+		return nil, nil, fmt.Errorf("%w: user can't list any of the resources", ErrForbidden)
+	}
+
+	rc := cluster.shared
+	if !rc.HasSynced() {
+		return nil, nil, ErrCacheNotSynced
+	}
+
+	hub := rc.watch
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	stream := &WatchStream{
+		Version:   hub.version,
+		Events:    make(chan models.WatchEventModel, watchStreamBuffer),
+		kinds:     kinds,
+		namespace: req.Namespace,
+		hub:       hub,
+	}
+
+	missed := []models.WatchEventModel{}
+	if req.ResourceVersion != 0 {
+		// versions are not persisted, after restart they start over
+		if req.ResourceVersion > hub.version {
+			return nil, nil, ErrWatchExpired
+		}
+		if len(hub.history) > 0 && hub.history[0].ResourceVersion > req.ResourceVersion+1 {
+			return nil, nil, ErrWatchExpired
+		}
+		for i := range hub.history {
+			if hub.history[i].ResourceVersion > req.ResourceVersion && stream.matches(&hub.history[i]) {
+				missed = append(missed, hub.history[i])
+			}
+		}
+	}
+
+	hub.streams[stream] = true
+
+	return stream, missed, nil
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"errors"
+	"log"
 	"time"
 
 	"gorm.io/driver/sqlite"
@@ -10,11 +11,12 @@ import (
 	"github.com/kube-dash/kube-dash-backend/models"
 )
 
-func InitDB() (*gorm.DB, error) {
+// open sqlite database at the path (or DSN, ex. "file::memory:") and migrate it
+func InitDB(path string) (*gorm.DB, error) {
 
 	// open local sqlite database
 	// TODO: change to more powerful database
-	db, err := gorm.Open(sqlite.Open("database.db"), &gorm.Config{})
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
@@ -24,58 +26,60 @@ func InitDB() (*gorm.DB, error) {
 		&models.DBContainerMetricsModel{},
 		&models.DBPodMetricsModel{},
 		&models.DBClusterMetricsModel{},
+		&models.DBNodeMetricsModel{},
+		&models.DBClusterNodeMetricsModel{},
+		&models.DBEventModel{},
+		&models.DBSnapshotModel{},
+		&models.DBSnapshotObjectModel{},
+		&models.DBMigrationModel{},
 	)
 
 	return db, nil
 
 }
 
-// remove pod metrics stored in the database
-func DBDeletePodMetrics(
-	db *gorm.DB,
-	starttime *time.Time, endtime *time.Time,
-) error {
-
-	tx := db.Begin() // start a transaction
+// where clause selecting records created in the time range, records created
+// after starttime or before endtime when only one is set and everything when none
+func timeRangeClause(starttime *time.Time, endtime *time.Time) (string, []interface{}, error) {
 
-	var whereClause string
-	var args []interface{}
 	if starttime != nil && endtime != nil {
 
 		// validate if starttime is not after than endtime
 		if starttime.After(*endtime) {
-			return errors.New("start_time cannot be after end_time")
+			return "", nil, errors.New("start_time cannot be after end_time")
 		}
 
-		// delete records created within the specified time range
-		whereClause = "created_at BETWEEN ? AND ?"
-		args = []interface{}{starttime, endtime}
+		// records created within the specified time range
+		return "created_at BETWEEN ? AND ?", []interface{}{starttime, endtime}, nil
 
 	} else if starttime != nil {
-		// delete records created after the specified start time
-		whereClause = "created_at >= ?"
-		args = []interface{}{starttime}
+		// records created after the specified start time
+		return "created_at >= ?", []interface{}{starttime}, nil
 	} else if endtime != nil {
-		// delete records created before the specified end time
-		whereClause = "created_at <= ?"
-		args = []interface{}{endtime}
-	} else {
-		// no time range specified, delete all records
-		whereClause = "1=1"
+		// records created before the specified end time
+		return "created_at <= ?", []interface{}{endtime}, nil
 	}
 
-	// define the models to be deleted
-	modelRows := []interface{}{
-		&models.DBContainerMetricsModel{},
-		&models.DBPodMetricsModel{},
-		&models.DBClusterMetricsModel{},
-	}
+	// no time range specified, all records
+	return "1=1", nil, nil
+}
+
+// records of the model matching the query
+type recordsDelete struct {
+	model interface{}
+	query string
+	arg   interface{}
+}
+
+// hard-delete records of the models in the transaction and commit it,
+// children have to come first while their parents can still be selected
+func deleteRecords(db *gorm.DB, tx *gorm.DB, deletes []recordsDelete) error {
 
 	// delete records from each model using the set query
-	for _, mr := range modelRows {
+	for _, d := range deletes {
 
 		// hard-delete records of the current model
-		err := tx.Unscoped().Where(whereClause, args...).Delete(mr).Error
+		err := tx.Unscoped().Where(d.query, d.arg).Delete(d.model).Error
 		if err != nil {
 			// rollback on error
 			tx.Rollback()
@@ -94,22 +98,86 @@ func DBDeletePodMetrics(
 	return nil
 }
 
-func cleanPodMetricsDB(db *gorm.DB) error {
+// remove pod metrics of the cluster (all clusters when empty) stored in the database
+func DBDeletePodMetrics(
+	db *gorm.DB,
+	cluster string,
+	starttime *time.Time, endtime *time.Time,
+) error {
+
+	whereClause, args, err := timeRangeClause(starttime, endtime)
+	if err != nil {
+		return err
+	}
+
+	tx := db.Begin() // start a transaction
+
+	// pods and containers don't know their cluster, they are
+	// selected through the records they belong to
+	records := tx.Model(&models.DBClusterMetricsModel{}).
+		Select("id").
+		Where(whereClause, args...)
+	if cluster != "" {
+		records = records.Where("cluster = ?", cluster)
+	}
+	pods := tx.Model(&models.DBPodMetricsModel{}).
+		Select("id").
+		Where("c_rec_id IN (?)", records)
+
+	return deleteRecords(db, tx, []recordsDelete{
+		{&models.DBContainerMetricsModel{}, "pod_id IN (?)", pods},
+		{&models.DBPodMetricsModel{}, "c_rec_id IN (?)", records},
+		{&models.DBClusterMetricsModel{}, "id IN (?)", records},
+	})
+}
+
+// remove node metrics of the cluster (all clusters when empty) stored in the database
+func DBDeleteNodeMetrics(
+	db *gorm.DB,
+	cluster string,
+	starttime *time.Time, endtime *time.Time,
+) error {
+
+	whereClause, args, err := timeRangeClause(starttime, endtime)
+	if err != nil {
+		return err
+	}
+
+	tx := db.Begin() // start a transaction
+
+	records := tx.Model(&models.DBClusterNodeMetricsModel{}).
+		Select("id").
+		Where(whereClause, args...)
+	if cluster != "" {
+		records = records.Where("cluster = ?", cluster)
+	}
+
+	return deleteRecords(db, tx, []recordsDelete{
+		{&models.DBNodeMetricsModel{}, "n_rec_id IN (?)", records},
+		{&models.DBClusterNodeMetricsModel{}, "id IN (?)", records},
+	})
+}
+
+func cleanMetricsDB(db *gorm.DB) error {
 	// remove everything before 168 hours ago (7 days)
 	endTime := time.Now().Add(-168 * time.Hour)
-	return DBDeletePodMetrics(db, nil, &endTime)
+	err := DBDeletePodMetrics(db, "", nil, &endTime)
+	if err != nil {
+		return err
+	}
+	return DBDeleteNodeMetrics(db, "", nil, &endTime)
 }
 
-// start a job that removes old metrics records from the database
-// and runs every 1 hour
-func StartDBPodMetricsCleaner(
+// start a job that removes old pod and node metrics records from
+// the database and runs every 1 hour
+func StartDBMetricsCleaner(
 	db *gorm.DB,
 ) error {
 
 	ticker := time.NewTicker(3600 * time.Second)
 	go func() {
 		for range ticker.C {
-			err := cleanPodMetricsDB(db)
+			err := cleanMetricsDB(db)
 			if err != nil {
 				// TODO: find a way to error handle the ticker
 				return
@@ -119,3 +187,54 @@ func StartDBPodMetricsCleaner(
 
 	return nil
 }
+
+// records stored before the server knew about more clusters belong
+// to the default one
+func AssignDefaultCluster(db *gorm.DB, cluster string) error {
+
+	for _, model := range []interface{}{
+		&models.DBClusterMetricsModel{},
+		&models.DBClusterNodeMetricsModel{},
+		&models.DBEventModel{},
+		&models.DBSnapshotModel{},
+	} {
+		// the column added to existing tables is null in their rows
+		err := db.Model(model).
+			Where("cluster IS NULL OR cluster = ''").
+			Update("cluster", cluster).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remove events which were last seen before endtime
+func DBDeleteEvents(db *gorm.DB, endtime time.Time) error {
+	return db.Unscoped().
+		Where("last_timestamp <= ?", endtime).
+		Delete(&models.DBEventModel{}).
+		Error
+}
+
+// start a job that removes events older than 30 days from the database
+// and runs every 1 hour
+func StartDBEventsCleaner(
+	db *gorm.DB,
+) error {
+
+	ticker := time.NewTicker(3600 * time.Second)
+	go func() {
+		for range ticker.C {
+			// the next tick removes what this one couldn't
+			err := DBDeleteEvents(db, time.Now().Add(-720*time.Hour))
+			if err != nil {
+				log.Printf("removing old events failed: %v", err)
+			}
+		}
+	}()
+
+	return nil
+}
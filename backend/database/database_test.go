@@ -0,0 +1,74 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func TestAssignDefaultCluster(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "kubedash.db")
+
+	// tables of the versions before clusters, with one record each
+	old, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []string{
+		"db_cluster_metrics_models", "db_cluster_node_metrics_models", "db_event_models", "db_snapshot_models",
+	} {
+		err = old.Exec("CREATE TABLE " + table + " (id integer PRIMARY KEY, created_at datetime, " +
+			"updated_at datetime, deleted_at datetime)").Error
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = old.Exec("INSERT INTO " + table + " (created_at) VALUES (CURRENT_TIMESTAMP)").Error
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	oldIns, _ := old.DB()
+	oldIns.Close()
+
+	db, err := InitDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		dbIns, _ := db.DB()
+		_ = dbIns.Close()
+	})
+	// record of the current version without cluster
+	if err := db.Create(&models.DBEventModel{UID: "uid-1"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	err = AssignDefaultCluster(db, "production")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		model   interface{}
+		records int64
+	}{
+		{&models.DBClusterMetricsModel{}, 1},
+		{&models.DBClusterNodeMetricsModel{}, 1},
+		{&models.DBEventModel{}, 2},
+		{&models.DBSnapshotModel{}, 1},
+	} {
+		var assigned int64
+		err := db.Model(test.model).Where("cluster = ?", "production").Count(&assigned).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+		if assigned != test.records {
+			t.Fatalf("expected %d records of %T assigned, got %d", test.records, test.model, assigned)
+		}
+	}
+}
@@ -43,14 +43,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/createdeployment": {
+        "/api/v1/applymanifests": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new deployment in the cluster with the given name, namespace and parameters.",
+                "description": "Server-side applies every object of multi document YAML or JSON manifest with kubedash field manager. Use dry_run=server to preview the results without persisting them, diff=true additionally returns the diff of every object against its live state.",
                 "consumes": [
                     "application/json"
                 ],
@@ -58,23 +58,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Deployment"
+                    "Resources"
                 ],
-                "summary": "Create New Deployment",
+                "summary": "Apply Manifests",
                 "parameters": [
                     {
-                        "description": "Request Model of Create Deployment",
+                        "description": "Request Model of Apply Manifests",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateDeploymentRequestModel"
+                            "$ref": "#/definitions/models.ApplyManifestsRequestModel"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ApplyManifestsResponseModel"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -82,20 +91,26 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/createservice": {
+        "/api/v1/cordonnode": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Creates service in kubernetes cluster. Currently only NodePort and ClusterIP are supported, LoadBalancer coming later.",
+                "description": "Marks the node unschedulable, pods already running on it are not affected",
                 "consumes": [
                     "application/json"
                 ],
@@ -103,17 +118,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Nodes"
                 ],
-                "summary": "Create Service",
+                "summary": "Cordon Node",
                 "parameters": [
                     {
-                        "description": "Request Model of Create Service",
+                        "description": "Request Model of Cordon Node",
                         "name": "request",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateServiceRequestModel"
+                            "$ref": "#/definitions/models.CordonNodeRequestModel"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -129,20 +151,26 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/deletedeployment": {
+        "/api/v1/createdeployment": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Removes the deployment by given name and namespace.",
+                "description": "Create a new deployment in the cluster with the given name, namespace and parameters. With diff set nothing is created, the server-side dry run result is returned as models.DiffModel.",
                 "consumes": [
                     "application/json"
                 ],
@@ -152,16 +180,22 @@ const docTemplate = `{
                 "tags": [
                     "Deployment"
                 ],
-                "summary": "Delete Deployment",
+                "summary": "Create New Deployment",
                 "parameters": [
                     {
-                        "description": "Request Model of Delete Deployment",
+                        "description": "Request Model of Create Deployment",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeleteDeploymentRequestModel"
+                            "$ref": "#/definitions/models.CreateDeploymentRequestModel"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -174,20 +208,26 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/deletepodmetrics": {
+        "/api/v1/createhpa": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete metrics from the database. When no parameters are specified, all metrics will be deleted.",
+                "description": "Creates horizontal pod autoscaler (autoscaling/v2) scaling the deployment or statefulset between min and max replicas by CPU and memory utilization targets",
                 "consumes": [
                     "application/json"
                 ],
@@ -195,17 +235,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Metrics"
+                    "Autoscaling"
                 ],
-                "summary": "Delete Pod Metrics",
+                "summary": "Create Horizontal Pod Autoscaler",
                 "parameters": [
                     {
-                        "description": "Request Model of Deleting Pod Metrics",
+                        "description": "Request Model of Create Horizontal Pod Autoscaler",
                         "name": "request",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeletePodMetricsRequestModel"
+                            "$ref": "#/definitions/models.HPAModel"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -221,20 +268,26 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/deleteservice": {
+        "/api/v1/createingress": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Removes the service by given name and namespace",
+                "description": "Creates ingress routing hosts and paths to services. Referenced services and their ports have to exist in the same namespace.",
                 "consumes": [
                     "application/json"
                 ],
@@ -242,17 +295,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Ingresses"
                 ],
-                "summary": "Delete Service",
+                "summary": "Create Ingress",
                 "parameters": [
                     {
-                        "description": "Request Model of Delete Service",
+                        "description": "Request Model of Create Ingress",
                         "name": "request",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeleteServiceRequestModel"
+                            "$ref": "#/definitions/models.CreateIngressRequestModel"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -268,20 +328,26 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/getpodmetrics": {
-            "get": {
+        "/api/v1/createlimitrange": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get metrics for specific pod or all pods in the cluster",
+                "description": "Creates limit range with the given defaults and min/max constraints",
                 "consumes": [
                     "application/json"
                 ],
@@ -289,22 +355,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Metrics"
+                    "Namespaces"
                 ],
-                "summary": "Get Pod Metrics (deprecated)",
-                "deprecated": true,
+                "summary": "Create Limit Range",
                 "parameters": [
+                    {
+                        "description": "Request Model of Create Limit Range",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LimitRangeModel"
+                        }
+                    },
                     {
                         "type": "string",
-                        "example": "default",
-                        "description": "Namespace to filter pod metrics",
-                        "name": "namespace",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -312,48 +388,58 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/listcontainers": {
-            "get": {
+        "/api/v1/createnamespace": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all available containers in the cluster",
+                "description": "Creates namespace with the given labels and annotations",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Containers"
+                    "Namespaces"
                 ],
-                "summary": "List Available Containers",
+                "summary": "Create Namespace",
                 "parameters": [
                     {
-                        "type": "string",
-                        "example": "default",
-                        "description": "Namespace of the pod containing the containers",
-                        "name": "namespace",
-                        "in": "query"
+                        "description": "Request Model of Create Namespace",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateNamespaceRequestModel"
+                        }
                     },
                     {
                         "type": "string",
-                        "example": "mypod",
-                        "description": "Name of the pod containing the containers",
-                        "name": "podName",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/models.ListContainersReponseModel"
+                            "type": "object"
                         }
                     },
                     "400": {
@@ -362,41 +448,58 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/listdeployments": {
-            "get": {
+        "/api/v1/createnetworkpolicy": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all deployments in the cluster",
+                "description": "Creates network policy for pods with the selector labels. Peers select pods by pod_selector in the namespace of the policy, by namespace_selector (with optional pod_selector) in other namespaces or IP ranges by cidr",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Deployment"
+                    "Policies"
                 ],
-                "summary": "List All Deployments",
+                "summary": "Create Network Policy",
                 "parameters": [
+                    {
+                        "description": "Request Model of Create Network Policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.NetworkPolicyModel"
+                        }
+                    },
                     {
                         "type": "string",
-                        "example": "default",
-                        "description": "Namespace to filter deployments",
-                        "name": "namespace",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/models.ListDeploymentsResponseModel"
+                            "type": "object"
                         }
                     },
                     "400": {
@@ -405,35 +508,58 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/listnamespaces": {
-            "get": {
+        "/api/v1/createpdb": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Returns the list of all available namespaces in the cluster",
+                "description": "Creates pod disruption budget for pods with the selector labels, either min_available or max_unavailable is required",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Namespaces"
+                    "Policies"
+                ],
+                "summary": "Create Pod Disruption Budget",
+                "parameters": [
+                    {
+                        "description": "Request Model of Create Pod Disruption Budget",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PDBModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List Available Namespaces",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "400": {
@@ -442,40 +568,59 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/listpods": {
-            "get": {
+        "/api/v1/createpersistentvolumeclaim": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all available pods in the cluster",
+                "description": "Creates persistent volume claim of the given size and storage class",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Storage"
                 ],
-                "summary": "List Available Pods (deprecated)",
-                "deprecated": true,
+                "summary": "Create Persistent Volume Claim",
                 "parameters": [
+                    {
+                        "description": "Request Model of Create Persistent Volume Claim",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePersistentVolumeClaimRequestModel"
+                        }
+                    },
                     {
                         "type": "string",
-                        "example": "default",
-                        "description": "Namespace to filter pods",
-                        "name": "namespace",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -483,41 +628,58 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/listservices": {
-            "get": {
+        "/api/v1/createresourcequota": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all available services in the cluster",
+                "description": "Creates resource quota with the given hard limits",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Namespaces"
                 ],
-                "summary": "List Available Services",
+                "summary": "Create Resource Quota",
                 "parameters": [
+                    {
+                        "description": "Request Model of Create Resource Quota",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceQuotaModel"
+                        }
+                    },
                     {
                         "type": "string",
-                        "example": "default",
-                        "description": "Namespace to filter services",
-                        "name": "namespace",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/models.ListServicesResponseModel"
+                            "type": "object"
                         }
                     },
                     "400": {
@@ -526,41 +688,58 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/login": {
-            "get": {
-                "description": "Returns a bearer token that has to be provided for authenticated endpoints",
+        "/api/v1/createservice": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates service in kubernetes cluster. Currently only NodePort and ClusterIP are supported, LoadBalancer coming later. With diff set nothing is created, the server-side dry run result is returned as models.DiffModel.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Login"
+                    "Services"
                 ],
-                "summary": "Login endpoint",
+                "summary": "Create Service",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Password for authentication",
-                        "name": "pass",
-                        "in": "query",
-                        "required": true
+                        "description": "Request Model of Create Service",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateServiceRequestModel"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "Username for authentication",
-                        "name": "user",
-                        "in": "query",
-                        "required": true
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
                     },
                     "400": {
                         "description": "Bad Request"
@@ -568,45 +747,86 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/restricted": {
-            "get": {
+        "/api/v1/createsnapshot": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "A check to see if user can reach restricted endpoints",
+                "description": "Save all objects of the namespace created by users (deployments, services, configmaps, secrets, ingresses, CRDs, ...) to the database as a save point. Secrets are stored encrypted with the server secret key. Fails with 403 when the user can't get secrets of the namespace, same as reading snapshots.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Test"
+                    "Snapshots"
+                ],
+                "summary": "Create Namespace Snapshot",
+                "parameters": [
+                    {
+                        "description": "Request Model of Create Snapshot",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateSnapshotRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Test authenticated endpoint",
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.SnapshotModel"
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v1/updatedeployment": {
+        "/api/v1/deletedeployment": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update the parameters of already existing deployment.",
+                "description": "Removes the deployment by given name and namespace. With diff set nothing is removed, the server-side dry run result is returned as models.DiffModel.",
                 "consumes": [
                     "application/json"
                 ],
@@ -616,16 +836,22 @@ const docTemplate = `{
                 "tags": [
                     "Deployment"
                 ],
-                "summary": "Update Existing Deployment",
+                "summary": "Delete Deployment",
                 "parameters": [
                     {
-                        "description": "Request Model of Update Deployment",
+                        "description": "Request Model of Delete Deployment",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateDeploymentRequestModel"
+                            "$ref": "#/definitions/models.DeleteDeploymentRequestModel"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -638,55 +864,178 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v2/getpodmetrics": {
-            "get": {
+        "/api/v1/deletehpa": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get metrics for specific pod or all pods in the cluster",
+                "description": "Removes the horizontal pod autoscaler by given name and namespace, the workload keeps its current replicas",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Metrics"
+                    "Autoscaling"
                 ],
-                "summary": "Get Pod Metrics",
+                "summary": "Delete Horizontal Pod Autoscaler",
                 "parameters": [
+                    {
+                        "description": "Request Model of Delete Horizontal Pod Autoscaler",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteHPARequestModel"
+                        }
+                    },
                     {
                         "type": "string",
-                        "example": "2024-08-24T20:30:00.000Z",
-                        "description": "End time for metric collection in RFC3339 format",
-                        "name": "endTime",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deleteingress": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the ingress by given name and namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingresses"
+                ],
+                "summary": "Delete Ingress",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Ingress",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteIngressRequestModel"
+                        }
                     },
                     {
                         "type": "string",
-                        "example": "mypod",
-                        "description": "Name of the pod for which to retrieve metrics",
-                        "name": "podName",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deletelimitrange": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the limit range by given name and namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Delete Limit Range",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Limit Range",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteLimitRangeRequestModel"
+                        }
                     },
                     {
                         "type": "string",
-                        "example": "2024-08-24T20:00:00.000Z",
-                        "description": "Start time for metric collection in RFC3339 format",
-                        "name": "startTime",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/models.DBClusterMetricsModel"
+                            "type": "object"
                         }
                     },
                     "400": {
@@ -695,33 +1044,50 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/v2/listpods": {
-            "get": {
+        "/api/v1/deletenamespace": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all available pods in the cluster",
+                "description": "Deletion is done in two steps. The call without confirmation_token returns 202 with a token valid for 5 minutes, the namespace is deleted only when the same user repeats the call with that token.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Namespaces"
                 ],
-                "summary": "List Available Pods",
+                "summary": "Delete Namespace",
                 "parameters": [
+                    {
+                        "description": "Request Model of Delete Namespace",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteNamespaceRequestModel"
+                        }
+                    },
                     {
                         "type": "string",
-                        "example": "default",
-                        "description": "Namespace to filter pods",
-                        "name": "namespace",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     }
                 ],
@@ -729,7 +1095,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListPodsV2ResponseModel"
+                            "$ref": "#/definitions/models.DeleteNamespaceResponseModel"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteNamespaceResponseModel"
                         }
                     },
                     "400": {
@@ -738,550 +1110,9979 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
                     "500": {
                         "description": "Internal Server Error"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.CreateDeploymentRequestModel": {
-            "type": "object",
-            "required": [
-                "image",
-                "name",
-                "namespace",
-                "replicas"
-            ],
-            "properties": {
-                "cpu_limit": {
-                    "description": "CPU limit for each pod in the deployment (default: 200m)",
-                    "type": "string",
-                    "example": "200m"
-                },
-                "cpu_request": {
-                    "description": "CPU request for each pod in the deployment (default: 100m)",
-                    "type": "string",
-                    "example": "100m"
-                },
-                "image": {
-                    "description": "Docker image to use in the deployment",
-                    "type": "string",
-                    "example": "nginx"
-                },
-                "memory_limit": {
-                    "description": "Memory limit for each pod in the deployment (default: 512Mi)",
-                    "type": "string",
-                    "example": "512Mi"
-                },
-                "memory_request": {
-                    "description": "Memory request for each pod in the deployment (default: 256Mi)",
-                    "type": "string",
-                    "example": "256Mi"
-                },
-                "name": {
-                    "description": "Name for the deployment",
-                    "type": "string",
-                    "example": "mydeployment"
-                },
-                "namespace": {
-                    "description": "Namespace for the deployment",
-                    "type": "string",
-                    "example": "default"
+        },
+        "/api/v1/deletenetworkpolicy": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the network policy by given name and namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "Delete Network Policy",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Network Policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteNetworkPolicyRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deletepdb": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the pod disruption budget by given name and namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "Delete Pod Disruption Budget",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Pod Disruption Budget",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeletePDBRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deletepodmetrics": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete metrics from the database. When no parameters are specified, all metrics will be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Delete Pod Metrics",
+                "parameters": [
+                    {
+                        "description": "Request Model of Deleting Pod Metrics",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeletePodMetricsRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deleteresource": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes single object of any resource kind, dependents are removed in background",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "Delete Object Of Any Resource",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Resource",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResourceRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deleteresourcequota": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the resource quota by given name and namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Delete Resource Quota",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Resource Quota",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResourceQuotaRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deleteservice": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the service by given name and namespace. With diff set nothing is removed, the server-side dry run result is returned as models.DiffModel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "Delete Service",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Service",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteServiceRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/deletesnapshot": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the snapshot and all its objects from the database. The user needs the get verb on secrets of the namespace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snapshots"
+                ],
+                "summary": "Delete Namespace Snapshot",
+                "parameters": [
+                    {
+                        "description": "Request Model of Delete Snapshot",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteSnapshotRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/diffsnapshot": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare the snapshot with the live state of its namespace. Returns the changes restore would make and objects created after the snapshot. The user needs the get verb on secrets of the namespace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snapshots"
+                ],
+                "summary": "Diff Namespace Snapshot",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "ID of the snapshot",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DiffSnapshotResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/drainnode": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cordons the node and evicts its pods in background honoring PodDisruptionBudgets. DaemonSet and static pods are skipped, so are pods not managed by a controller unless force is set and pods with emptyDir volumes unless delete_emptydir_data is set; the operation fails when pods were left on the node for these reasons. Returns the operation which progress can be polled with /api/v1/getoperation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Drain Node",
+                "parameters": [
+                    {
+                        "description": "Request Model of Drain Node",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DrainNodeRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.OperationModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/evaluatenetworkpolicy": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Answer whether the source pod can connect to the port of the destination pod according to the network policies in the namespaces of both pods. Egress policies of the source and ingress policies of the destination have to allow it, pods not selected by any policy of a direction are not isolated. Named ports are resolved from the destination pod, ip blocks are matched against pod IPs. Only the policies are evaluated, not the network plugin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "Evaluate Network Policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "payments",
+                        "description": "Namespace of the pod connected to",
+                        "name": "destinationNamespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "api-5d8-fghij",
+                        "description": "Name of the pod connected to",
+                        "name": "destinationPod",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "8080",
+                        "description": "Port number or name of the destination container port",
+                        "name": "port",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "TCP",
+                            "UDP",
+                            "SCTP"
+                        ],
+                        "type": "string",
+                        "example": "TCP",
+                        "description": "Protocol of the connection (default: TCP)",
+                        "name": "protocol",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the connecting pod",
+                        "name": "sourceNamespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "web-7c9f-abcde",
+                        "description": "Name of the connecting pod",
+                        "name": "sourcePod",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EvaluateNetworkPolicyResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/expandpersistentvolumeclaim": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Increases the requested size of the claim. Fails with 400 when the storage class doesn't allow volume expansion or the size isn't bigger than the current one. Claims without storage class use the class of their bound volume and can't be expanded while unbound.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Storage"
+                ],
+                "summary": "Expand Persistent Volume Claim",
+                "parameters": [
+                    {
+                        "description": "Request Model of Expand Persistent Volume Claim",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ExpandPersistentVolumeClaimRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/exportnamespace": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download every object of the namespace created by users as YAML manifests in tar.gz or zip archive, one file per object. Objects owned by controllers (ex. pods of deployments) and runtime data like events are left out.",
+                "produces": [
+                    "application/gzip",
+                    "application/zip"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "Export Namespace",
+                "parameters": [
+                    {
+                        "enum": [
+                            "tar",
+                            "zip"
+                        ],
+                        "type": "string",
+                        "example": "tar",
+                        "description": "Archive format, tar (gzip compressed) when empty",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to export",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/exportresource": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get single object of any resource kind as YAML manifest without status, namespace and fields assigned by the cluster (uid, resourceVersion, managedFields, clusterIP, ...), ready to be applied elsewhere",
+                "produces": [
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "Export Object As YAML",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "apps",
+                        "description": "API group of the resource, empty for the core group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deployment",
+                        "description": "Name of the object",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the object, ignored for cluster scoped resources",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "deployments",
+                        "description": "Plural name of the resource",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "v1",
+                        "description": "API version of the resource, preferred version is used when empty",
+                        "name": "version",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/geteventhistory": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get events collected to the database, most recent first. Events are kept for 30 days. The user needs the list verb on events of the namespace (cluster wide without namespace).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Get Event History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:30:00.000Z",
+                        "description": "End of the time range (by last occurrence) in RFC3339 format",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Pod",
+                        "description": "Kind of the involved object",
+                        "name": "involvedKind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deploy-59849dcb58-tdknv",
+                        "description": "Name of the involved object",
+                        "name": "involvedName",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 5000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 500,
+                        "description": "Maximum number of returned events (default: 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter events",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BackOff",
+                        "description": "Reason of the event",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:00:00.000Z",
+                        "description": "Start of the time range (by last occurrence) in RFC3339 format",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Normal",
+                            "Warning"
+                        ],
+                        "type": "string",
+                        "example": "Warning",
+                        "description": "Type of the event",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListEventsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/getnode": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get details of the node including its addresses and pods scheduled on it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Get Node Details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "worker-1",
+                        "description": "Name of the node",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetNodeResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/getnodemetrics": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get CPU and memory usage with allocatable resources of specific node or all nodes in the cluster, with the same time range rules as /api/v2/getpodmetrics. The user needs the list verb on nodes.metrics.k8s.io",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Get Node Metrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:30:00.000Z",
+                        "description": "End time for metric collection in RFC3339 format",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "worker-1",
+                        "description": "Name of the node for which to retrieve metrics",
+                        "name": "nodeName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:00:00.000Z",
+                        "description": "Start time for metric collection in RFC3339 format",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DBClusterNodeMetricsModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/getoperation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get state and per object progress of the background operation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operations"
+                ],
+                "summary": "Get Operation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "5b8c7f0e-3a4d-4e0b-9a52-0c3d6f1e2b7a",
+                        "description": "Identifier of the operation",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OperationModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    }
+                }
+            }
+        },
+        "/api/v1/getpodmetrics": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get metrics for specific pod or all pods in the cluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Get Pod Metrics (deprecated)",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter pod metrics",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/getresource": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get single object of any resource kind as returned by the API server",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "Get Object Of Any Resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "apps",
+                        "description": "API group of the resource, empty for the core group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deployment",
+                        "description": "Name of the object",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the object, ignored for cluster scoped resources",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "deployments",
+                        "description": "Plural name of the resource",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "v1",
+                        "description": "API version of the resource, preferred version is used when empty",
+                        "name": "version",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/getresourcetree": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get ownership tree of any object as nodes and edges. The tree starts at the top level owner of the object, ex. Deployment -\u003e ReplicaSets -\u003e Pods when asked for a pod.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Topology"
+                ],
+                "summary": "Get Resource Tree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "apps",
+                        "description": "API group of the resource, empty for the core group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deploy-59849dcb58-tdknv",
+                        "description": "Name of the object",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the object, ignored for cluster scoped resources",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "pods",
+                        "description": "Plural name of the resource",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "v1",
+                        "description": "API version of the resource, preferred version is used when empty",
+                        "name": "version",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTreeResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/gettopology": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get graph of the namespace as nodes and edges: ingresses route to services, services select pods, workloads own pods and pods mount or reference config maps, secrets and persistent volume claims. Referenced objects which don't exist are included with Missing status. Kinds the user can't list are left out, objects of such kinds referenced by pods are included with Unknown status. Config maps and secrets are read without their data.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Topology"
+                ],
+                "summary": "Get Namespace Topology",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to build the graph for",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TopologyResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/getworkloadmetrics": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get CPU and memory usage of the pods of workloads or namespaces aggregated per interval from the collected pod metrics. Pods are assigned to workloads by their owner references, the time range is limited to 72 hours and 1000 intervals. The user needs the list verb on pods.metrics.k8s.io in the namespace (cluster wide without namespace)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Get Workload Metrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T22:00:00.000Z",
+                        "description": "End time of the aggregated time range in RFC3339 format",
+                        "name": "endTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "workload",
+                            "namespace"
+                        ],
+                        "type": "string",
+                        "example": "workload",
+                        "description": "Aggregate usage per workload or per namespace (default: workload)",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "5m",
+                        "description": "Length of the aggregation intervals as duration, at least 5s (default: 1m)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the pods to aggregate",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:00:00.000Z",
+                        "description": "Start time of the aggregated time range in RFC3339 format",
+                        "name": "startTime",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "Deployment",
+                            "StatefulSet",
+                            "DaemonSet",
+                            "Job",
+                            "ReplicaSet"
+                        ],
+                        "type": "string",
+                        "example": "Deployment",
+                        "description": "Kind of the workloads to aggregate (Deployment, StatefulSet, DaemonSet, Job or ReplicaSet)",
+                        "name": "workloadKind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deployment",
+                        "description": "Name of the workload to aggregate",
+                        "name": "workloadName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetWorkloadMetricsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listapiresources": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all resource kinds served by the cluster in their preferred version, including CRDs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "List API Resources",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListAPIResourcesResponseModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listclusterrolebindings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get cluster role bindings with their role and subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "List Cluster Role Bindings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRoleBindingsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listclusterroles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get cluster roles with their rules, rules aggregated from other cluster roles are included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "List Cluster Roles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRolesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listclusters": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all clusters the server is configured with, their Kubernetes version and health. Clusters are selected with the cluster parameter of every other endpoint.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clusters"
+                ],
+                "summary": "List Clusters",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListClustersResponseModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    }
+                }
+            }
+        },
+        "/api/v1/listcontainers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all available containers in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Containers"
+                ],
+                "summary": "List Available Containers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": false,
+                        "description": "Read directly from the API server instead of the cache",
+                        "name": "fresh",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the pod containing the containers",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "mypod",
+                        "description": "Name of the pod containing the containers",
+                        "name": "podName",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListContainersReponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listdeployments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all deployments in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deployment"
+                ],
+                "summary": "List All Deployments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": false,
+                        "description": "Read directly from the API server instead of the cache",
+                        "name": "fresh",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter deployments",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListDeploymentsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listevents": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get current events from the cluster, most recent first. Kubernetes keeps events only for about an hour, use /api/v1/geteventhistory for older ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "List Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Pod",
+                        "description": "Kind of the involved object",
+                        "name": "involvedKind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deploy-59849dcb58-tdknv",
+                        "description": "Name of the involved object",
+                        "name": "involvedName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter events",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BackOff",
+                        "description": "Reason of the event",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Normal",
+                            "Warning"
+                        ],
+                        "type": "string",
+                        "example": "Warning",
+                        "description": "Type of the event",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListEventsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listhpas": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get horizontal pod autoscalers with their targets, current and desired replicas and current metric values",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Autoscaling"
+                ],
+                "summary": "List Horizontal Pod Autoscalers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter horizontal pod autoscalers",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListHPAsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listingresses": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all ingresses in the cluster together with the addresses assigned by the load balancer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingresses"
+                ],
+                "summary": "List Available Ingresses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter ingresses",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListIngressesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listlimitranges": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get limit ranges with their defaults and min/max constraints",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "List Limit Ranges",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "payments",
+                        "description": "Namespace to filter limit ranges",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListLimitRangesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listnamespaces": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the list of all available namespaces in the cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "List Available Namespaces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listnetworkpolicies": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get network policies with their pod selectors, policy types and rules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "List Network Policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter network policies",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListNetworkPoliciesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listnodes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all nodes with capacity, allocatable resources, requests of scheduled pods, taints and conditions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "List Nodes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListNodesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listoperations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get running background operations and the ones finished within last hour",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operations"
+                ],
+                "summary": "List Operations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListOperationsResponseModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    }
+                }
+            }
+        },
+        "/api/v1/listpdbs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get pod disruption budgets with their healthy pods and currently allowed disruptions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "List Pod Disruption Budgets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter pod disruption budgets",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListPDBsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listpersistentvolumeclaims": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get persistent volume claims with their binding status, capacity and pods mounting them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Storage"
+                ],
+                "summary": "List Persistent Volume Claims",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter persistent volume claims",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListPersistentVolumeClaimsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listpersistentvolumes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all persistent volumes in the cluster with the claims bound to them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Storage"
+                ],
+                "summary": "List Persistent Volumes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListPersistentVolumesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listpods": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all available pods in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "List Available Pods (deprecated)",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": false,
+                        "description": "Read directly from the API server instead of the cache",
+                        "name": "fresh",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter pods",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listresourcequotas": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get resource quotas with current usage against hard limits",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "List Resource Quotas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "payments",
+                        "description": "Namespace to filter resource quotas",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListResourceQuotasResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listresources": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get objects of any resource kind as a table with the columns defined by the server (additionalPrinterColumns for CRDs). Every row includes the full object.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "List Objects Of Any Resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "apps",
+                        "description": "API group of the resource, empty for the core group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter namespaced resources",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "deployments",
+                        "description": "Plural name of the resource",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "v1",
+                        "description": "API version of the resource, preferred version is used when empty",
+                        "name": "version",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListResourcesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listrolebindings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get role bindings with their role and subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "List Role Bindings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter role bindings",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRoleBindingsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listroles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get roles with their rules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "List Roles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter roles",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRolesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listserviceaccounts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get service accounts with their secrets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "List Service Accounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter service accounts",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListServiceAccountsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listservices": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all available services in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "List Available Services",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": false,
+                        "description": "Read directly from the API server instead of the cache",
+                        "name": "fresh",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter services",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListServicesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/listsnapshots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get snapshots stored in the database, most recent first. Snapshots hold secrets of their namespace, only snapshots of namespaces the user can get secrets in are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snapshots"
+                ],
+                "summary": "List Namespace Snapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Only snapshots of this namespace, all when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListSnapshotsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/liststorageclasses": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all storage classes in the cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Storage"
+                ],
+                "summary": "List Storage Classes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListStorageClassesResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/login": {
+            "get": {
+                "description": "Returns a bearer token that has to be provided for authenticated endpoints",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Login"
+                ],
+                "summary": "Login endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Password for authentication",
+                        "name": "pass",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username for authentication",
+                        "name": "user",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/proxy/{kind}/{namespace}/{name}/{port}/{path}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Forward the request to a port of the pod or service through the proxy subresource of the API server. The path after the port, query (without the cluster parameter), headers and streamed body are forwarded, Authorization, Cookie, Impersonate-* and hop-by-hop headers are not. WebSocket (and other) upgrades are passed through. When impersonating, the user needs the verb of the method (get, create, update, patch, delete) on pods/proxy or services/proxy. Without impersonation the proxy is disabled unless the server runs with -allow-proxy, every logged in user can then reach every pod and service. Every request is written to the audit log.",
+                "tags": [
+                    "Proxy"
+                ],
+                "summary": "Proxy To Pod Or Service",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pods",
+                            "services"
+                        ],
+                        "type": "string",
+                        "description": "Kind of the target",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod or service",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the pod or service",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Port number or name of the container or service port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path on the target port",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Forward the request to a port of the pod or service through the proxy subresource of the API server. The path after the port, query (without the cluster parameter), headers and streamed body are forwarded, Authorization, Cookie, Impersonate-* and hop-by-hop headers are not. WebSocket (and other) upgrades are passed through. When impersonating, the user needs the verb of the method (get, create, update, patch, delete) on pods/proxy or services/proxy. Without impersonation the proxy is disabled unless the server runs with -allow-proxy, every logged in user can then reach every pod and service. Every request is written to the audit log.",
+                "tags": [
+                    "Proxy"
+                ],
+                "summary": "Proxy To Pod Or Service",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pods",
+                            "services"
+                        ],
+                        "type": "string",
+                        "description": "Kind of the target",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod or service",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the pod or service",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Port number or name of the container or service port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path on the target port",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Forward the request to a port of the pod or service through the proxy subresource of the API server. The path after the port, query (without the cluster parameter), headers and streamed body are forwarded, Authorization, Cookie, Impersonate-* and hop-by-hop headers are not. WebSocket (and other) upgrades are passed through. When impersonating, the user needs the verb of the method (get, create, update, patch, delete) on pods/proxy or services/proxy. Without impersonation the proxy is disabled unless the server runs with -allow-proxy, every logged in user can then reach every pod and service. Every request is written to the audit log.",
+                "tags": [
+                    "Proxy"
+                ],
+                "summary": "Proxy To Pod Or Service",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pods",
+                            "services"
+                        ],
+                        "type": "string",
+                        "description": "Kind of the target",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod or service",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the pod or service",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Port number or name of the container or service port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path on the target port",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Forward the request to a port of the pod or service through the proxy subresource of the API server. The path after the port, query (without the cluster parameter), headers and streamed body are forwarded, Authorization, Cookie, Impersonate-* and hop-by-hop headers are not. WebSocket (and other) upgrades are passed through. When impersonating, the user needs the verb of the method (get, create, update, patch, delete) on pods/proxy or services/proxy. Without impersonation the proxy is disabled unless the server runs with -allow-proxy, every logged in user can then reach every pod and service. Every request is written to the audit log.",
+                "tags": [
+                    "Proxy"
+                ],
+                "summary": "Proxy To Pod Or Service",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pods",
+                            "services"
+                        ],
+                        "type": "string",
+                        "description": "Kind of the target",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod or service",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the pod or service",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Port number or name of the container or service port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path on the target port",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Forward the request to a port of the pod or service through the proxy subresource of the API server. The path after the port, query (without the cluster parameter), headers and streamed body are forwarded, Authorization, Cookie, Impersonate-* and hop-by-hop headers are not. WebSocket (and other) upgrades are passed through. When impersonating, the user needs the verb of the method (get, create, update, patch, delete) on pods/proxy or services/proxy. Without impersonation the proxy is disabled unless the server runs with -allow-proxy, every logged in user can then reach every pod and service. Every request is written to the audit log.",
+                "tags": [
+                    "Proxy"
+                ],
+                "summary": "Proxy To Pod Or Service",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pods",
+                            "services"
+                        ],
+                        "type": "string",
+                        "description": "Kind of the target",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod or service",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the pod or service",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Port number or name of the container or service port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path on the target port",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/ready": {
+            "get": {
+                "description": "Reports whether the resource cache of the cluster finished its initial sync. Until then list endpoints read directly from the API server and search is unavailable.",
+                "tags": [
+                    "Test"
+                ],
+                "summary": "Readiness Check",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ready",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "503": {
+                        "description": "Not ready",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/restoresnapshot": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Re-create or reconcile every object of the snapshot with server-side apply, the namespace is created if it doesn't exist. Objects created after the snapshot are left untouched. Use dry_run=server to preview the results.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snapshots"
+                ],
+                "summary": "Restore Namespace Snapshot",
+                "parameters": [
+                    {
+                        "description": "Request Model of Restore Snapshot",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RestoreSnapshotRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ApplyManifestsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/restricted": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "A check to see if user can reach restricted endpoints",
+                "tags": [
+                    "Test"
+                ],
+                "summary": "Test authenticated endpoint",
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reviewaccess": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Asks the API server whether the request is allowed (SelfSubjectAccessReview), for the logged in user when impersonating and for the server's own credentials otherwise",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Review Access",
+                "parameters": [
+                    {
+                        "description": "Request Model of Review Access",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AccessReviewRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AccessReviewResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Search pods, workloads, services, ingresses, config maps, claims, namespaces and nodes in all namespaces by name substring, label selector, annotation and container image. All given criteria have to match. Served from the resource cache, secrets are not searchable.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search Resources",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "prometheus.io/scrape=true",
+                        "description": "Annotation key or key=value the objects have to have",
+                        "name": "annotation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx:1.27",
+                        "description": "Substring of container image used by the object",
+                        "name": "image",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Pod,Deployment",
+                        "description": "Comma separated kinds to search, all supported kinds when empty",
+                        "name": "kinds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "team=payments",
+                        "description": "Label selector, ex. team=payments,tier!=frontend",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of hits returned, 100 when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Only objects in this namespace, all namespaces when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx",
+                        "description": "Case insensitive substring of the object name",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SearchResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/api/v1/subjectpermissions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get rules the roles and bindings of the cluster grant to the user, group or service account, directly or through its groups",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Subject Permissions",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "example": [
+                            "developers"
+                        ],
+                        "description": "Groups of the user, bindings to these groups are included",
+                        "name": "groups",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "User",
+                            "Group",
+                            "ServiceAccount"
+                        ],
+                        "type": "string",
+                        "example": "ServiceAccount",
+                        "description": "Kind of the subject (User, Group or ServiceAccount)",
+                        "name": "kind",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "builder",
+                        "description": "Name of the subject",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the service account",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubjectPermissionsResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/uncordonnode": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Marks the node schedulable again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Uncordon Node",
+                "parameters": [
+                    {
+                        "description": "Request Model of Uncordon Node",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CordonNodeRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updatedeployment": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the parameters of already existing deployment. Setting replicas of a deployment scaled by a horizontal pod autoscaler returns warnings, the autoscaler overrides the count. With diff set nothing is updated, the server-side dry run result is returned as models.DiffModel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deployment"
+                ],
+                "summary": "Update Existing Deployment",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Deployment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateDeploymentRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateDeploymentResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updatehpa": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces target, replica bounds and metrics of the horizontal pod autoscaler, metrics other than the given CPU and memory utilization are removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Autoscaling"
+                ],
+                "summary": "Update Horizontal Pod Autoscaler",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Horizontal Pod Autoscaler",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HPAModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updateingress": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces rules, TLS and ingress class of already existing ingress. Referenced services and their ports have to exist in the same namespace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingresses"
+                ],
+                "summary": "Update Ingress",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Ingress",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateIngressRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updatelimitrange": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces limits of the limit range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Update Limit Range",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Limit Range",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LimitRangeModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updatenamespace": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces labels and annotations of the namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Update Namespace",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Namespace",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateNamespaceRequestModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updatenetworkpolicy": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces pod selector, policy types and rules of the network policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "Update Network Policy",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Network Policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.NetworkPolicyModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updatepdb": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces selector and budget of the pod disruption budget",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policies"
+                ],
+                "summary": "Update Pod Disruption Budget",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Pod Disruption Budget",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PDBModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/updateresourcequota": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces hard limits of the resource quota",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Update Resource Quota",
+                "parameters": [
+                    {
+                        "description": "Request Model of Update Resource Quota",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceQuotaModel"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v1/watch": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stream ADDED, MODIFIED and DELETED changes of pods, deployments, services and events as server-sent events. Objects are summarized the same way as by the list endpoints. Every change has increasing id, reconnecting EventSource resumes with Last-Event-ID automatically. Too old (or unknown after restart) resource version returns 410, list again and watch without it. Ping event is sent every 15 seconds.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Watch"
+                ],
+                "summary": "Watch Resource Changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Only changes in this namespace, all namespaces when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1024,
+                        "description": "Resume after this resource version (id of the last received event), Last-Event-ID header is used when empty",
+                        "name": "resourceVersion",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "pods,deployments",
+                        "description": "Comma separated resources to watch (pods, deployments, services, events), all when empty",
+                        "name": "resources",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resume after this resource version",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WatchEventModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "410": {
+                        "description": "Gone"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/api/v1/whocan": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get subjects the roles and bindings of the cluster allow the request, with the bindings granting it. Subjects can be groups, other authorizers than RBAC are not considered",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Who Can",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "apps",
+                        "description": "API group of the resource, empty for the core group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx",
+                        "description": "Name of the object, any object when empty",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the request, cluster wide when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "deployments",
+                        "description": "Resource in plural form",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "scale",
+                        "description": "Subresource (ex. log, scale, exec)",
+                        "name": "subresource",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "delete",
+                        "description": "Verb of the request (ex. get, list, create, delete)",
+                        "name": "verb",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WhoCanResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v2/getpodmetrics": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get metrics for specific pod or all pods in the cluster. The user needs the list verb on pods.metrics.k8s.io in the namespace (cluster wide without namespace)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Get Pod Metrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:30:00.000Z",
+                        "description": "End time for metric collection in RFC3339 format",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace of the pods for which to retrieve metrics",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "mypod",
+                        "description": "Name of the pod for which to retrieve metrics",
+                        "name": "podName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-08-24T20:00:00.000Z",
+                        "description": "Start time for metric collection in RFC3339 format",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Deployment",
+                            "StatefulSet",
+                            "DaemonSet",
+                            "Job",
+                            "ReplicaSet"
+                        ],
+                        "type": "string",
+                        "example": "Deployment",
+                        "description": "Kind of the workload owning the pods (Deployment, StatefulSet, DaemonSet, Job or ReplicaSet)",
+                        "name": "workloadKind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "nginx-deployment",
+                        "description": "Name of the workload owning the pods",
+                        "name": "workloadName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DBClusterMetricsModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v2/listnamespaces": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns all namespaces with their status, age, labels and resource quota usage",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "List Namespaces With Details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListNamespacesV2ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/api/v2/listpods": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all available pods in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "List Available Pods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9",
+                        "description": "Token of the next page returned in pagination of the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "metadata.name=nginx",
+                        "description": "Field selector to filter objects, fields supported depend on the resource",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": false,
+                        "description": "Read directly from the API server instead of the cache",
+                        "name": "fresh",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "app=nginx,tier!=cache",
+                        "description": "Label selector to filter objects",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 100,
+                        "description": "Maximum number of objects in the page, all objects are returned when empty",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "default",
+                        "description": "Namespace to filter pods",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort order (asc or desc, default: asc), ascending age starts with the youngest objects",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "age",
+                            "status",
+                            "restarts"
+                        ],
+                        "type": "string",
+                        "example": "age",
+                        "description": "Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the cluster, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListPodsV2ResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.APIResourceModel": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "API group of the resource, empty for the core group.",
+                    "type": "string",
+                    "example": "apps"
+                },
+                "kind": {
+                    "description": "Kind of the objects.",
+                    "type": "string",
+                    "example": "Deployment"
+                },
+                "namespaced": {
+                    "description": "Whether the objects live in namespaces.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "resource": {
+                    "description": "Plural name of the resource.",
+                    "type": "string",
+                    "example": "deployments"
+                },
+                "verbs": {
+                    "description": "Verbs supported by the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "get",
+                        "list",
+                        "delete"
+                    ]
+                },
+                "version": {
+                    "description": "Preferred API version of the resource.",
+                    "type": "string",
+                    "example": "v1"
+                }
+            }
+        },
+        "models.AccessReviewRequestModel": {
+            "type": "object",
+            "required": [
+                "resource",
+                "verb"
+            ],
+            "properties": {
+                "group": {
+                    "description": "API group of the resource, empty for the core group",
+                    "type": "string",
+                    "example": "apps"
+                },
+                "name": {
+                    "description": "Name of the object, any object when empty",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "Namespace of the request, cluster wide when empty",
+                    "type": "string",
+                    "example": "default"
+                },
+                "resource": {
+                    "description": "Resource in plural form",
+                    "type": "string",
+                    "example": "deployments"
+                },
+                "subresource": {
+                    "description": "Subresource (ex. log, scale, exec)",
+                    "type": "string",
+                    "example": "scale"
+                },
+                "verb": {
+                    "description": "Verb of the request (ex. get, list, create, delete)",
+                    "type": "string",
+                    "example": "create"
+                }
+            }
+        },
+        "models.AccessReviewResponseModel": {
+            "type": "object",
+            "properties": {
+                "allowed": {
+                    "description": "Whether the request is allowed.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "denied": {
+                    "description": "Whether the request is explicitly denied, set by some authorizers.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "evaluation_error": {
+                    "description": "Error of the authorizer, the request may be allowed by rules it couldn't evaluate.",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Reason of the decision given by the authorizer.",
+                    "type": "string",
+                    "example": "RBAC: allowed by ClusterRoleBinding \"admins\""
+                }
+            }
+        },
+        "models.ApplyManifestsRequestModel": {
+            "type": "object",
+            "required": [
+                "manifest"
+            ],
+            "properties": {
+                "diff": {
+                    "description": "Only preview the changes with server-side dry run and return their diff",
+                    "type": "boolean",
+                    "example": false
+                },
+                "dry_run": {
+                    "description": "Set to server to only preview the result without persisting anything",
+                    "type": "string",
+                    "enum": [
+                        "server"
+                    ],
+                    "example": "server"
+                },
+                "force": {
+                    "description": "Take over fields owned by other field managers instead of failing on conflicts",
+                    "type": "boolean",
+                    "example": false
+                },
+                "manifest": {
+                    "description": "One or more YAML documents separated by --- or JSON objects",
+                    "type": "string",
+                    "example": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  mode: prod\n"
+                },
+                "namespace": {
+                    "description": "Namespace used for namespaced objects which don't specify one (default: default)",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.ApplyManifestsResponseModel": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "description": "Whether the changes were only previewed.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "objects": {
+                    "description": "Results in the order of objects in the manifest.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ApplyManifestsResponseModelObject"
+                    }
+                }
+            }
+        },
+        "models.ApplyManifestsResponseModelObject": {
+            "type": "object",
+            "properties": {
+                "api_version": {
+                    "description": "API version of the object.",
+                    "type": "string",
+                    "example": "v1"
+                },
+                "diff": {
+                    "description": "Changes the apply would make, set only when diff was requested.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DiffModel"
+                        }
+                    ]
+                },
+                "error": {
+                    "description": "Reason of the failure when result is error.",
+                    "type": "string",
+                    "example": "namespaces \"staging\" not found"
+                },
+                "kind": {
+                    "description": "Kind of the object.",
+                    "type": "string",
+                    "example": "ConfigMap"
+                },
+                "name": {
+                    "description": "The name of the object.",
+                    "type": "string",
+                    "example": "settings"
+                },
+                "namespace": {
+                    "description": "The namespace of the object, empty for cluster scoped resources.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "result": {
+                    "description": "Outcome of the apply (created, configured, unchanged or error).",
+                    "type": "string",
+                    "example": "created"
+                }
+            }
+        },
+        "models.ClusterModel": {
+            "type": "object",
+            "properties": {
+                "context": {
+                    "description": "Kubeconfig context of the cluster.",
+                    "type": "string",
+                    "example": "admin@production"
+                },
+                "default": {
+                    "description": "Whether requests without the cluster parameter go to this cluster.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "error": {
+                    "description": "Why the cluster is unreachable or unhealthy.",
+                    "type": "string",
+                    "example": "dial tcp 10.0.0.1:6443: i/o timeout"
+                },
+                "healthy": {
+                    "description": "Whether the API server reports it's ready (/readyz).",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "description": "Name of the cluster used in the cluster parameter.",
+                    "type": "string",
+                    "example": "production"
+                },
+                "reachable": {
+                    "description": "Whether the API server answered.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "server": {
+                    "description": "Address of the API server.",
+                    "type": "string",
+                    "example": "https://10.0.0.1:6443"
+                },
+                "version": {
+                    "description": "Kubernetes version of the API server, empty when unreachable.",
+                    "type": "string",
+                    "example": "v1.31.0"
+                }
+            }
+        },
+        "models.CordonNodeRequestModel": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the node to cordon or uncordon",
+                    "type": "string",
+                    "example": "worker-1"
+                }
+            }
+        },
+        "models.CreateDeploymentRequestModel": {
+            "type": "object",
+            "required": [
+                "image",
+                "name",
+                "namespace",
+                "replicas"
+            ],
+            "properties": {
+                "cpu_limit": {
+                    "description": "CPU limit for each pod in the deployment (default: 200m)",
+                    "type": "string",
+                    "example": "200m"
+                },
+                "cpu_request": {
+                    "description": "CPU request for each pod in the deployment (default: 100m)",
+                    "type": "string",
+                    "example": "100m"
+                },
+                "diff": {
+                    "description": "Only preview the changes with server-side dry run and return their diff",
+                    "type": "boolean",
+                    "example": false
+                },
+                "image": {
+                    "description": "Docker image to use in the deployment",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "memory_limit": {
+                    "description": "Memory limit for each pod in the deployment (default: 512Mi)",
+                    "type": "string",
+                    "example": "512Mi"
+                },
+                "memory_request": {
+                    "description": "Memory request for each pod in the deployment (default: 256Mi)",
+                    "type": "string",
+                    "example": "256Mi"
+                },
+                "name": {
+                    "description": "Name for the deployment",
+                    "type": "string",
+                    "example": "mydeployment"
+                },
+                "namespace": {
+                    "description": "Namespace for the deployment",
+                    "type": "string",
+                    "example": "default"
+                },
+                "replicas": {
+                    "description": "Number of replicas for the deployment",
+                    "type": "integer",
+                    "maximum": 32,
+                    "minimum": 1,
+                    "example": 2
+                }
+            }
+        },
+        "models.CreateIngressRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace",
+                "rules"
+            ],
+            "properties": {
+                "ingress_class": {
+                    "description": "Name of the IngressClass handling the ingress, cluster default is used when empty",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "name": {
+                    "description": "Name for the ingress",
+                    "type": "string",
+                    "example": "nginx-ingress"
+                },
+                "namespace": {
+                    "description": "Namespace for the ingress",
+                    "type": "string",
+                    "example": "default"
+                },
+                "rules": {
+                    "description": "Routing rules of the ingress",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.IngressRuleModel"
+                    }
+                },
+                "tls": {
+                    "description": "TLS configuration of the ingress",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngressTLSModel"
+                    }
+                }
+            }
+        },
+        "models.CreateNamespaceRequestModel": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "annotations": {
+                    "description": "Annotations of the namespace",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"owner\"": " \"john\"}"
+                    }
+                },
+                "labels": {
+                    "description": "Labels of the namespace",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"team\"": " \"payments\"}"
+                    }
+                },
+                "name": {
+                    "description": "Name for the namespace",
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "models.CreatePersistentVolumeClaimRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace",
+                "size"
+            ],
+            "properties": {
+                "access_modes": {
+                    "description": "Access modes of the claim (default: ReadWriteOnce)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ReadWriteOnce"
+                    ]
+                },
+                "name": {
+                    "description": "Name for the claim",
+                    "type": "string",
+                    "example": "data"
+                },
+                "namespace": {
+                    "description": "Namespace for the claim",
+                    "type": "string",
+                    "example": "default"
+                },
+                "size": {
+                    "description": "Requested size in k8s format",
+                    "type": "string",
+                    "example": "10Gi"
+                },
+                "storage_class": {
+                    "description": "Name of the StorageClass, cluster default is used when empty",
+                    "type": "string",
+                    "example": "standard"
+                }
+            }
+        },
+        "models.CreateServiceRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace",
+                "port",
+                "selector",
+                "type"
+            ],
+            "properties": {
+                "diff": {
+                    "description": "Only preview the changes with server-side dry run and return their diff",
+                    "type": "boolean",
+                    "example": false
+                },
+                "external_ips": {
+                    "description": "List of external IPs to expose the service on (not used yet)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "10.1.2.30",
+                        "10.2.2.30"
+                    ]
+                },
+                "name": {
+                    "description": "Name for the service",
+                    "type": "string",
+                    "example": "nginx-service"
+                },
+                "namespace": {
+                    "description": "Namespace for the service",
+                    "type": "string",
+                    "example": "default"
+                },
+                "node_port": {
+                    "description": "External node port for NodePort service type",
+                    "type": "integer",
+                    "maximum": 32767,
+                    "minimum": 30000,
+                    "example": 30080
+                },
+                "port": {
+                    "description": "Port of the service",
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 1,
+                    "example": 80
+                },
+                "selector": {
+                    "description": "Selector to match pods for the service. To create service for specific deployment use {\"app\": deploymentname}.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"app\"": " \"nginx\"}"
+                    }
+                },
+                "target_port": {
+                    "description": "Target port on the container",
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 1,
+                    "example": 80
+                },
+                "type": {
+                    "description": "Type of service (ClusterIP or NodePort) - load balancers not yet supported",
+                    "type": "string",
+                    "enum": [
+                        "ClusterIP",
+                        "NodePort"
+                    ]
+                }
+            }
+        },
+        "models.CreateSnapshotRequestModel": {
+            "type": "object",
+            "required": [
+                "label",
+                "namespace"
+            ],
+            "properties": {
+                "label": {
+                    "description": "Label describing the snapshot",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "before-migration"
+                },
+                "namespace": {
+                    "description": "Namespace to snapshot",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DBClusterMetricsModel": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "Name of the cluster the metrics were collected from.",
+                    "type": "string",
+                    "example": "production"
+                },
+                "pods": {
+                    "description": "Timestamp indicating when the record was created. This is the time when the metrics were collected.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DBPodMetricsModel"
+                    }
+                }
+            }
+        },
+        "models.DBClusterNodeMetricsModel": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "Name of the cluster the metrics were collected from.",
+                    "type": "string",
+                    "example": "production"
+                },
+                "nodes": {
+                    "description": "Metrics records of the nodes.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DBNodeMetricsModel"
+                    }
+                }
+            }
+        },
+        "models.DBContainerMetricsModel": {
+            "type": "object",
+            "properties": {
+                "cpu_usage": {
+                    "description": "CPU usage of the container in millicores (1/1000th of a core).",
+                    "type": "integer",
+                    "example": 20
+                },
+                "memory_usage": {
+                    "description": "Memory usage of the container in bytes.",
+                    "type": "integer",
+                    "example": 10485760
+                },
+                "name": {
+                    "description": "Name of the container.",
+                    "type": "string",
+                    "example": "nginx"
+                }
+            }
+        },
+        "models.DBEventModel": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "Name of the cluster the event comes from, set only for stored events.",
+                    "type": "string",
+                    "example": "production"
+                },
+                "count": {
+                    "description": "How many times the event occurred.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "first_timestamp": {
+                    "description": "The time the event was first seen.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "involved_kind": {
+                    "description": "Kind of the object the event is about.",
+                    "type": "string",
+                    "example": "Pod"
+                },
+                "involved_name": {
+                    "description": "Name of the object the event is about.",
+                    "type": "string",
+                    "example": "nginx-deploy-59849dcb58-tdknv"
+                },
+                "last_timestamp": {
+                    "description": "The time the event was last seen.",
+                    "type": "string",
+                    "example": "2024-08-24T20:05:00.000Z"
+                },
+                "message": {
+                    "description": "Human readable description of the event.",
+                    "type": "string",
+                    "example": "Back-off restarting failed container"
+                },
+                "namespace": {
+                    "description": "Namespace of the event.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Machine readable reason of the event.",
+                    "type": "string",
+                    "example": "BackOff"
+                },
+                "source": {
+                    "description": "Component that reported the event.",
+                    "type": "string",
+                    "example": "kubelet"
+                },
+                "type": {
+                    "description": "Type of the event (Normal or Warning).",
+                    "type": "string",
+                    "example": "Warning"
+                },
+                "uid": {
+                    "description": "UID of the kubernetes event, used for deduplication.",
+                    "type": "string",
+                    "example": "3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"
+                }
+            }
+        },
+        "models.DBNodeMetricsModel": {
+            "type": "object",
+            "properties": {
+                "cpu_allocatable": {
+                    "description": "CPU of the node allocatable by pods in millicores.",
+                    "type": "integer",
+                    "example": 3800
+                },
+                "cpu_usage": {
+                    "description": "CPU usage of the node in millicores (1/1000th of a core).",
+                    "type": "integer",
+                    "example": 1250
+                },
+                "memory_allocatable": {
+                    "description": "Memory of the node allocatable by pods in bytes.",
+                    "type": "integer",
+                    "example": 7516192768
+                },
+                "memory_usage": {
+                    "description": "Memory usage of the node in bytes.",
+                    "type": "integer",
+                    "example": 3221225472
+                },
+                "name": {
+                    "description": "Name of the node.",
+                    "type": "string",
+                    "example": "worker-1"
+                },
+                "timestamp": {
+                    "description": "Time the usage was measured by the metrics server.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                }
+            }
+        },
+        "models.DBPodMetricsModel": {
+            "type": "object",
+            "properties": {
+                "containers": {
+                    "description": "Metrics records grouped by containers.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DBContainerMetricsModel"
+                    }
+                },
+                "name": {
+                    "description": "Name of the pod.",
+                    "type": "string",
+                    "example": "mypod"
+                },
+                "namespace": {
+                    "description": "Namespace of the pod, empty for records stored before namespaces were recorded whose pod couldn't be identified.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "node": {
+                    "description": "Node the pod runs on.",
+                    "type": "string",
+                    "example": "worker-1"
+                },
+                "uid": {
+                    "description": "UID of the pod, tells apart pods recreated with the same name, empty for records stored before UIDs were recorded.",
+                    "type": "string",
+                    "example": "3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"
+                },
+                "workload_kind": {
+                    "description": "Kind of the workload owning the pod (ex. Deployment or StatefulSet), empty for pods without controller.",
+                    "type": "string",
+                    "example": "Deployment"
+                },
+                "workload_name": {
+                    "description": "Name of the workload owning the pod.",
+                    "type": "string",
+                    "example": "nginx-deployment"
+                }
+            }
+        },
+        "models.DeleteDeploymentRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "diff": {
+                    "description": "Only preview the changes with server-side dry run and return their diff",
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "description": "Name of the deployment to delete",
+                    "type": "string",
+                    "example": "mydeployment"
+                },
+                "namespace": {
+                    "description": "Namespace of the deployment to delete",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DeleteHPARequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the horizontal pod autoscaler to delete",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "Namespace of the horizontal pod autoscaler to delete",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DeleteIngressRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the ingress to delete",
+                    "type": "string",
+                    "example": "nginx-ingress"
+                },
+                "namespace": {
+                    "description": "Namespace of the ingress to delete",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DeleteLimitRangeRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the limit range to delete",
+                    "type": "string",
+                    "example": "defaults"
+                },
+                "namespace": {
+                    "description": "Namespace of the limit range to delete",
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "models.DeleteNamespaceRequestModel": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "confirmation_token": {
+                    "description": "Token returned by the first call without it, confirms the deletion",
+                    "type": "string",
+                    "example": "1724529600.6d1c..."
+                },
+                "name": {
+                    "description": "Name of the namespace to delete",
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "models.DeleteNamespaceResponseModel": {
+            "type": "object",
+            "properties": {
+                "confirmation_token": {
+                    "description": "Token to send back to confirm the deletion, set only when confirmation is required.",
+                    "type": "string",
+                    "example": "1724529600.6d1c..."
+                },
+                "expires_at": {
+                    "description": "Expiration time of the token.",
+                    "type": "string",
+                    "example": "2024-08-24T20:05:00.000Z"
+                },
+                "status": {
+                    "description": "Status of the request.",
+                    "type": "string",
+                    "example": "confirmation required"
+                }
+            }
+        },
+        "models.DeleteNetworkPolicyRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the network policy to delete",
+                    "type": "string",
+                    "example": "nginx-ingress"
+                },
+                "namespace": {
+                    "description": "Namespace of the network policy to delete",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DeletePDBRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the pod disruption budget to delete",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "Namespace of the pod disruption budget to delete",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DeletePodMetricsRequestModel": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "description": "End time of metrics to delete in RFC3339 format",
+                    "type": "string",
+                    "example": "2024-08-24T20:30:00.000Z"
+                },
+                "start_time": {
+                    "description": "Start time of metrics to delete in RFC3339 format",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                }
+            }
+        },
+        "models.DeleteResourceQuotaRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the resource quota to delete",
+                    "type": "string",
+                    "example": "compute"
+                },
+                "namespace": {
+                    "description": "Namespace of the resource quota to delete",
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "models.DeleteResourceRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "resource"
+            ],
+            "properties": {
+                "group": {
+                    "description": "API group of the resource, empty for the core group",
+                    "type": "string",
+                    "example": "apps"
+                },
+                "name": {
+                    "description": "Name of the object to delete",
+                    "type": "string",
+                    "example": "nginx-deployment"
+                },
+                "namespace": {
+                    "description": "Namespace of the object, ignored for cluster scoped resources",
+                    "type": "string",
+                    "example": "default"
+                },
+                "resource": {
+                    "description": "Plural name of the resource",
+                    "type": "string",
+                    "example": "deployments"
+                },
+                "version": {
+                    "description": "API version of the resource, preferred version is used when empty",
+                    "type": "string",
+                    "example": "v1"
+                }
+            }
+        },
+        "models.DeleteServiceRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "diff": {
+                    "description": "Only preview the changes with server-side dry run and return their diff",
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "description": "Name of the service to delete",
+                    "type": "string",
+                    "example": "myservice"
+                },
+                "namespace": {
+                    "description": "Namespace of the service to delete",
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "models.DeleteSnapshotRequestModel": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "description": "ID of the snapshot",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.DiffChangeModel": {
+            "type": "object",
+            "properties": {
+                "new": {
+                    "description": "Value after the change."
+                },
+                "old": {
+                    "description": "Value before the change."
+                },
+                "path": {
+                    "description": "Path of the changed field, ex. spec.template.spec.containers[0].image.",
+                    "type": "string",
+                    "example": "spec.replicas"
+                },
+                "type": {
+                    "description": "Type of the change (added, removed or changed).",
+                    "type": "string",
+                    "example": "changed"
+                }
+            }
+        },
+        "models.DiffModel": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "Changed fields, managedFields and status are ignored.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DiffChangeModel"
+                    }
+                },
+                "kind": {
+                    "description": "Kind of the object.",
+                    "type": "string",
+                    "example": "Deployment"
+                },
+                "name": {
+                    "description": "The name of the object.",
+                    "type": "string",
+                    "example": "nginx-deployment"
+                },
+                "namespace": {
+                    "description": "The namespace of the object, empty for cluster scoped resources.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "unified": {
+                    "description": "The same changes as unified diff of the YAML representation.",
+                    "type": "string",
+                    "example": "--- live/default/deployment/nginx-deployment\n+++ result/default/deployment/nginx-deployment\n@@ -10,3 +10,3 @@\n spec:\n-  replicas: 2\n+  replicas: 3\n"
+                },
+                "warnings": {
+                    "description": "Warnings about the changes, ex. replicas managed by an autoscaler.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.DiffSnapshotResponseModel": {
+            "type": "object",
+            "properties": {
+                "not_in_snapshot": {
+                    "description": "Objects created after the snapshot, restore leaves them untouched.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SnapshotObjectRefModel"
+                    }
+                },
+                "objects": {
+                    "description": "Changes restore would make, only objects which differ are included.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DiffModel"
+                    }
+                },
+                "snapshot": {
+                    "description": "The snapshot compared with the live state.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SnapshotModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.DrainNodeRequestModel": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "delete_emptydir_data": {
+                    "description": "Evict pods with emptyDir volumes, their data is deleted (default: false)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "force": {
+                    "description": "Evict pods not managed by a controller, they are not recreated anywhere (default: false)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "description": "Name of the node to drain",
+                    "type": "string",
+                    "example": "worker-1"
+                },
+                "timeout_seconds": {
+                    "description": "How long to keep retrying evictions blocked by PodDisruptionBudgets and wait for pods to terminate (default: 300)",
+                    "type": "integer",
+                    "maximum": 3600,
+                    "minimum": 0,
+                    "example": 300
+                }
+            }
+        },
+        "models.EvaluateNetworkPolicyResponseModel": {
+            "type": "object",
+            "properties": {
+                "allowed": {
+                    "description": "Whether the connection is allowed, both egress and ingress have to allow it.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "egress": {
+                    "description": "Verdict of the egress policies of the source pod.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NetworkPolicyVerdictModel"
+                        }
+                    ]
+                },
+                "ingress": {
+                    "description": "Verdict of the ingress policies of the destination pod.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NetworkPolicyVerdictModel"
+                        }
+                    ]
+                },
+                "port": {
+                    "description": "Destination port number, named ports are resolved.",
+                    "type": "integer",
+                    "example": 8080
+                },
+                "protocol": {
+                    "description": "Protocol of the connection.",
+                    "type": "string",
+                    "example": "TCP"
+                }
+            }
+        },
+        "models.ExpandPersistentVolumeClaimRequestModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace",
+                "size"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the claim",
+                    "type": "string",
+                    "example": "data"
+                },
+                "namespace": {
+                    "description": "Namespace of the claim",
+                    "type": "string",
+                    "example": "default"
+                },
+                "size": {
+                    "description": "New size in k8s format, has to be bigger than the current one",
+                    "type": "string",
+                    "example": "20Gi"
+                }
+            }
+        },
+        "models.GetNodeResponseModel": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "Addresses of the node by type.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"InternalIP\"": " \"192.168.49.2\"}"
+                    }
+                },
+                "allocatable": {
+                    "description": "Resources available for pods.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NodeResourcesModel"
+                        }
+                    ]
+                },
+                "capacity": {
+                    "description": "Total resources of the node.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NodeResourcesModel"
+                        }
+                    ]
+                },
+                "conditions": {
+                    "description": "Conditions reported by the kubelet.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NodeConditionModel"
+                    }
+                },
+                "container_runtime": {
+                    "description": "Container runtime of the node.",
+                    "type": "string",
+                    "example": "containerd://1.7.19"
+                },
+                "creation_time": {
+                    "description": "The creation time of the node.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "kubelet_version": {
+                    "description": "The version of the kubelet.",
+                    "type": "string",
+                    "example": "v1.31.0"
+                },
+                "name": {
+                    "description": "The name of the node.",
+                    "type": "string",
+                    "example": "worker-1"
+                },
+                "os_image": {
+                    "description": "Operating system image of the node.",
+                    "type": "string",
+                    "example": "Ubuntu 22.04.4 LTS"
+                },
+                "pod_count": {
+                    "description": "Number of non terminated pods on the node.",
+                    "type": "integer",
+                    "example": 12
+                },
+                "pods": {
+                    "description": "Pods scheduled on the node.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListPodsV2ResponseModelPod"
+                    }
+                },
+                "requested": {
+                    "description": "Sum of resource requests of pods running on the node.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NodeResourcesModel"
+                        }
+                    ]
+                },
+                "roles": {
+                    "description": "Roles taken from node-role.kubernetes.io labels.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "control-plane"
+                    ]
+                },
+                "status": {
+                    "description": "Readiness of the node (Ready, NotReady or Unknown).",
+                    "type": "string",
+                    "example": "Ready"
+                },
+                "taints": {
+                    "description": "Taints of the node.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NodeTaintModel"
+                    }
+                },
+                "unschedulable": {
+                    "description": "Whether the node is cordoned.",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "models.GetWorkloadMetricsResponseModel": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "description": "Length of the intervals.",
+                    "type": "string",
+                    "example": "5m0s"
+                },
+                "workloads": {
+                    "description": "Aggregated usage of the workloads or namespaces, sorted by namespace, kind and name.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkloadMetricsModel"
+                    }
+                }
+            }
+        },
+        "models.GraphEdgeModel": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "description": "ID of the node the edge starts at.",
+                    "type": "string",
+                    "example": "3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"
+                },
+                "target": {
+                    "description": "ID of the node the edge leads to.",
+                    "type": "string",
+                    "example": "9a8b7c6d-5e4f-3a2b-1c0d-e9f8a7b6c5d4"
+                },
+                "type": {
+                    "description": "Relation of the nodes, one of owns, routes, selects, mounts and references.",
+                    "type": "string",
+                    "example": "owns"
+                }
+            }
+        },
+        "models.GraphNodeModel": {
+            "type": "object",
+            "properties": {
+                "api_version": {
+                    "description": "API version of the object.",
+                    "type": "string",
+                    "example": "apps/v1"
+                },
+                "id": {
+                    "description": "Unique ID of the node, UID of the object or missing/\u003ckind\u003e/\u003cname\u003e for objects which are referenced but weren't found.",
+                    "type": "string",
+                    "example": "3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"
+                },
+                "kind": {
+                    "description": "Kind of the object.",
+                    "type": "string",
+                    "example": "Deployment"
+                },
+                "name": {
+                    "description": "The name of the object.",
+                    "type": "string",
+                    "example": "nginx-deployment"
+                },
+                "namespace": {
+                    "description": "The namespace of the object, empty for cluster scoped resources.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "status": {
+                    "description": "Short status, ex. pod phase, ready replicas of workloads, Missing or Unknown (kind the user can't list).",
+                    "type": "string",
+                    "example": "2/3 ready"
+                }
+            }
+        },
+        "models.HPAMetricModel": {
+            "type": "object",
+            "properties": {
+                "average_value": {
+                    "description": "Average value per pod, set for average value targets.",
+                    "type": "string",
+                    "example": "500m"
+                },
+                "name": {
+                    "description": "The name of the resource or metric.",
+                    "type": "string",
+                    "example": "cpu"
+                },
+                "type": {
+                    "description": "Type of the metric source (Resource, ContainerResource, Pods, Object or External).",
+                    "type": "string",
+                    "example": "Resource"
+                },
+                "utilization": {
+                    "description": "Average utilization in percent of the requests, set for utilization targets.",
+                    "type": "integer",
+                    "example": 70
+                },
+                "value": {
+                    "description": "Total value, set for value targets.",
+                    "type": "string",
+                    "example": "100"
+                }
+            }
+        },
+        "models.HPAModel": {
+            "type": "object",
+            "required": [
+                "max_replicas",
+                "name",
+                "namespace",
+                "target_kind",
+                "target_name"
+            ],
+            "properties": {
+                "cpu_utilization": {
+                    "description": "Target average CPU utilization in percent of the requests, not used when 0",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 70
+                },
+                "max_replicas": {
+                    "description": "Highest number of replicas, not lower than min_replicas",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 10
+                },
+                "memory_utilization": {
+                    "description": "Target average memory utilization in percent of the requests, not used when 0",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 80
+                },
+                "min_replicas": {
+                    "description": "Lowest number of replicas (default: 1)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "name": {
+                    "description": "Name of the horizontal pod autoscaler",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "Namespace of the horizontal pod autoscaler and its target",
+                    "type": "string",
+                    "example": "default"
+                },
+                "target_kind": {
+                    "description": "Kind of the scaled workload",
+                    "type": "string",
+                    "enum": [
+                        "Deployment",
+                        "StatefulSet"
+                    ],
+                    "example": "Deployment"
+                },
+                "target_name": {
+                    "description": "Name of the scaled workload",
+                    "type": "string",
+                    "example": "nginx"
+                }
+            }
+        },
+        "models.IngressPathModel": {
+            "type": "object",
+            "required": [
+                "path",
+                "service_name"
+            ],
+            "properties": {
+                "path": {
+                    "description": "Path matched against the request URL",
+                    "type": "string",
+                    "example": "/"
+                },
+                "path_type": {
+                    "description": "How the path is matched (Exact, Prefix or ImplementationSpecific, default: Prefix)",
+                    "type": "string",
+                    "enum": [
+                        "Exact",
+                        "Prefix",
+                        "ImplementationSpecific"
+                    ],
+                    "example": "Prefix"
+                },
+                "service_name": {
+                    "description": "Name of the backend service in the same namespace",
+                    "type": "string",
+                    "example": "nginx-service"
+                },
+                "service_port": {
+                    "description": "Port number of the backend service, either this or service_port_name has to be set",
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 0,
+                    "example": 80
+                },
+                "service_port_name": {
+                    "description": "Port name of the backend service",
+                    "type": "string",
+                    "example": "http"
+                }
+            }
+        },
+        "models.IngressRuleModel": {
+            "type": "object",
+            "required": [
+                "paths"
+            ],
+            "properties": {
+                "host": {
+                    "description": "Host the rule applies to, empty means all hosts",
+                    "type": "string",
+                    "example": "nginx.example.com"
+                },
+                "paths": {
+                    "description": "Paths routed to backend services",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.IngressPathModel"
+                    }
+                }
+            }
+        },
+        "models.IngressTLSModel": {
+            "type": "object",
+            "required": [
+                "secret_name"
+            ],
+            "properties": {
+                "hosts": {
+                    "description": "Hosts covered by the TLS certificate",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "nginx.example.com"
+                    ]
+                },
+                "secret_name": {
+                    "description": "Name of the secret holding the TLS certificate and key",
+                    "type": "string",
+                    "example": "nginx-tls"
+                }
+            }
+        },
+        "models.LimitRangeItemModel": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "default": {
+                    "description": "Default limits by resource name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"cpu\"": " \"500m\"}"
+                    }
+                },
+                "default_request": {
+                    "description": "Default requests by resource name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"cpu\"": " \"100m\"}"
+                    }
+                },
+                "max": {
+                    "description": "Maximum usage by resource name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"cpu\"": " \"2\"}"
+                    }
+                },
+                "min": {
+                    "description": "Minimum usage by resource name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"cpu\"": " \"50m\"}"
+                    }
+                },
+                "type": {
+                    "description": "Kind of object the limits apply to (Container, Pod or PersistentVolumeClaim)",
+                    "type": "string",
+                    "enum": [
+                        "Container",
+                        "Pod",
+                        "PersistentVolumeClaim"
+                    ],
+                    "example": "Container"
+                }
+            }
+        },
+        "models.LimitRangeModel": {
+            "type": "object",
+            "required": [
+                "limits",
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "limits": {
+                    "description": "Limits grouped by object kind",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.LimitRangeItemModel"
+                    }
+                },
+                "name": {
+                    "description": "Name of the limit range",
+                    "type": "string",
+                    "example": "defaults"
+                },
+                "namespace": {
+                    "description": "Namespace of the limit range",
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "models.ListAPIResourcesResponseModel": {
+            "type": "object",
+            "properties": {
+                "resources": {
+                    "description": "A list of APIResourceModel containing all resources served by the cluster.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIResourceModel"
+                    }
+                }
+            }
+        },
+        "models.ListClustersResponseModel": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "description": "A list of ClusterModel containing all configured clusters.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClusterModel"
+                    }
+                }
+            }
+        },
+        "models.ListContainersReponseModel": {
+            "type": "object",
+            "properties": {
+                "containers": {
+                    "description": "A list of ListContainersReponseModelContainer objects representing the containers.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListContainersReponseModelContainer"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the pods containing the containers, count is the number of pods.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListContainersReponseModelContainer": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "description": "The image used by the container.",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "name": {
+                    "description": "The name of the container.",
+                    "type": "string",
+                    "example": "nginx-deployment"
+                },
+                "namespace": {
+                    "description": "The namespace of the container.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "ports": {
+                    "description": "A list of ListContainersReponseModelPort objects representing port mappings for the container.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListContainersReponseModelPort"
+                    }
+                },
+                "resources": {
+                    "description": "Resource limits and requests for the container.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ListContainersReponseModelResources"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListContainersReponseModelPort": {
+            "type": "object",
+            "properties": {
+                "container_port": {
+                    "description": "The container port number.",
+                    "type": "integer",
+                    "example": 9512
+                },
+                "name": {
+                    "description": "The name of the port.",
+                    "type": "string",
+                    "example": "myport"
+                },
+                "protocol": {
+                    "description": "The protocol used by the port.",
+                    "type": "string",
+                    "example": "TCP"
+                }
+            }
+        },
+        "models.ListContainersReponseModelResources": {
+            "type": "object",
+            "properties": {
+                "cpu_limit": {
+                    "description": "The CPU limit for the container (e.g., \"200m\").",
+                    "type": "string",
+                    "example": "200m"
+                },
+                "cpu_request": {
+                    "description": "The CPU request for the container (e.g., \"100m\").",
+                    "type": "string",
+                    "example": "100m"
+                },
+                "memory_limit": {
+                    "description": "The memory limit for the container (e.g., \"1Gi\").",
+                    "type": "string",
+                    "example": "1Gi"
+                },
+                "memory_request": {
+                    "description": "The memory request for the container (e.g., \"512Mi\").",
+                    "type": "string",
+                    "example": "512Mi"
+                }
+            }
+        },
+        "models.ListDeploymentsResponseModel": {
+            "type": "object",
+            "properties": {
+                "deployments": {
+                    "description": "A slice of ListDeploymentsResponseModelDeployment objects representing the deployments.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListDeploymentsResponseModelDeployment"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListDeploymentsResponseModelDeployment": {
+            "type": "object",
+            "properties": {
+                "creation_time": {
+                    "description": "The creation time of the deployment.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "name": {
+                    "description": "The name of the deployment.",
+                    "type": "string",
+                    "example": "nginx-deployment"
+                },
+                "namespace": {
+                    "description": "The namespace of the deployment.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "ready_replicas": {
+                    "description": "The number of ready replicas in the deployment.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "replicas": {
+                    "description": "The number of replicas in the deployment.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "unavailable_replicas": {
+                    "description": "The number of unavailable replicas in the deployment.",
+                    "type": "integer",
+                    "example": 0
+                },
+                "updated_replicas": {
+                    "description": "The number of updated replicas in the deployment.",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.ListEventsResponseModel": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "A list of events, most recent first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DBEventModel"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListHPAsResponseModel": {
+            "type": "object",
+            "properties": {
+                "hpas": {
+                    "description": "A list of ListHPAsResponseModelHPA containing horizontal pod autoscalers data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListHPAsResponseModelHPA"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListHPAsResponseModelHPA": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "description": "Current values of the metrics, empty until the autoscaler reads them.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HPAMetricModel"
+                    }
+                },
+                "current_replicas": {
+                    "description": "Number of replicas the workload has now.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "desired_replicas": {
+                    "description": "Number of replicas the autoscaler wants.",
+                    "type": "integer",
+                    "example": 4
+                },
+                "last_scale_time": {
+                    "description": "Time of the last scaling, empty when it never scaled.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00Z"
+                },
+                "max_replicas": {
+                    "description": "Highest number of replicas.",
+                    "type": "integer",
+                    "example": 10
+                },
+                "min_replicas": {
+                    "description": "Lowest number of replicas.",
+                    "type": "integer",
+                    "example": 2
+                },
+                "name": {
+                    "description": "The name of the horizontal pod autoscaler.",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "The namespace of the horizontal pod autoscaler.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "target_kind": {
+                    "description": "Kind of the scaled workload.",
+                    "type": "string",
+                    "example": "Deployment"
+                },
+                "target_name": {
+                    "description": "The name of the scaled workload.",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "targets": {
+                    "description": "Metric targets of the autoscaler.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HPAMetricModel"
+                    }
+                }
+            }
+        },
+        "models.ListIngressesResponseModel": {
+            "type": "object",
+            "properties": {
+                "ingresses": {
+                    "description": "A list of ListIngressesResponseModelIngress containing ingresses data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListIngressesResponseModelIngress"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListIngressesResponseModelIngress": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "IPs and hostnames assigned by the load balancer.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "192.168.49.2"
+                    ]
+                },
+                "creation_time": {
+                    "description": "The creation time of the ingress.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "ingress_class": {
+                    "description": "The IngressClass handling the ingress.",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "name": {
+                    "description": "The name of the ingress.",
+                    "type": "string",
+                    "example": "nginx-ingress"
+                },
+                "namespace": {
+                    "description": "The namespace of the ingress.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "rules": {
+                    "description": "Routing rules of the ingress.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngressRuleModel"
+                    }
+                },
+                "tls": {
+                    "description": "TLS configuration of the ingress.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngressTLSModel"
+                    }
+                }
+            }
+        },
+        "models.ListLimitRangesResponseModel": {
+            "type": "object",
+            "properties": {
+                "limit_ranges": {
+                    "description": "A list of LimitRangeModel containing limit ranges data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LimitRangeModel"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListNamespacesV2ResponseModel": {
+            "type": "object",
+            "properties": {
+                "namespaces": {
+                    "description": "A list of ListNamespacesV2ResponseModelNamespace containing namespaces data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListNamespacesV2ResponseModelNamespace"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListNamespacesV2ResponseModelNamespace": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "description": "Human readable age of the namespace.",
+                    "type": "string",
+                    "example": "3d4h"
+                },
+                "annotations": {
+                    "description": "Annotations of the namespace.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"owner\"": " \"john\"}"
+                    }
+                },
+                "creation_time": {
+                    "description": "The creation time of the namespace.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "labels": {
+                    "description": "Labels of the namespace.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"team\"": " \"payments\"}"
+                    }
+                },
+                "name": {
+                    "description": "The name of the namespace.",
+                    "type": "string",
+                    "example": "payments"
+                },
+                "quota": {
+                    "description": "Usage against hard limits of all resource quotas in the namespace, empty when the user can't list quotas.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ResourceQuotaUsageModel"
+                    }
+                },
+                "status": {
+                    "description": "The phase of the namespace (Active or Terminating).",
+                    "type": "string",
+                    "example": "Active"
+                }
+            }
+        },
+        "models.ListNetworkPoliciesResponseModel": {
+            "type": "object",
+            "properties": {
+                "network_policies": {
+                    "description": "A list of ListNetworkPoliciesResponseModelPolicy containing network policies data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListNetworkPoliciesResponseModelPolicy"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListNetworkPoliciesResponseModelPolicy": {
+            "type": "object",
+            "properties": {
+                "egress": {
+                    "description": "Rules of allowed outgoing connections.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkPolicyRuleSummaryModel"
+                    }
+                },
+                "ingress": {
+                    "description": "Rules of allowed incoming connections.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkPolicyRuleSummaryModel"
+                    }
+                },
+                "name": {
+                    "description": "The name of the network policy.",
+                    "type": "string",
+                    "example": "nginx-ingress"
+                },
+                "namespace": {
+                    "description": "The namespace of the network policy.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "pod_selector": {
+                    "description": "Label query of the pods the policy applies to.",
+                    "type": "string",
+                    "example": "app=nginx"
+                },
+                "policy_types": {
+                    "description": "Directions the policy isolates.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Ingress"
+                    ]
+                }
+            }
+        },
+        "models.ListNodesResponseModel": {
+            "type": "object",
+            "properties": {
+                "nodes": {
+                    "description": "A list of ListNodesResponseModelNode containing nodes data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListNodesResponseModelNode"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListNodesResponseModelNode": {
+            "type": "object",
+            "properties": {
+                "allocatable": {
+                    "description": "Resources available for pods.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NodeResourcesModel"
+                        }
+                    ]
+                },
+                "capacity": {
+                    "description": "Total resources of the node.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NodeResourcesModel"
+                        }
+                    ]
+                },
+                "conditions": {
+                    "description": "Conditions reported by the kubelet.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NodeConditionModel"
+                    }
+                },
+                "creation_time": {
+                    "description": "The creation time of the node.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "kubelet_version": {
+                    "description": "The version of the kubelet.",
+                    "type": "string",
+                    "example": "v1.31.0"
+                },
+                "name": {
+                    "description": "The name of the node.",
+                    "type": "string",
+                    "example": "worker-1"
+                },
+                "pod_count": {
+                    "description": "Number of non terminated pods on the node.",
+                    "type": "integer",
+                    "example": 12
+                },
+                "requested": {
+                    "description": "Sum of resource requests of pods running on the node.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NodeResourcesModel"
+                        }
+                    ]
+                },
+                "roles": {
+                    "description": "Roles taken from node-role.kubernetes.io labels.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "control-plane"
+                    ]
+                },
+                "status": {
+                    "description": "Readiness of the node (Ready, NotReady or Unknown).",
+                    "type": "string",
+                    "example": "Ready"
+                },
+                "taints": {
+                    "description": "Taints of the node.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NodeTaintModel"
+                    }
+                },
+                "unschedulable": {
+                    "description": "Whether the node is cordoned.",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "models.ListOperationsResponseModel": {
+            "type": "object",
+            "properties": {
+                "operations": {
+                    "description": "A list of OperationModel containing background operations.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OperationModel"
+                    }
+                }
+            }
+        },
+        "models.ListPDBsResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "pdbs": {
+                    "description": "A list of ListPDBsResponseModelPDB containing pod disruption budgets data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListPDBsResponseModelPDB"
+                    }
+                }
+            }
+        },
+        "models.ListPDBsResponseModelPDB": {
+            "type": "object",
+            "properties": {
+                "current_healthy": {
+                    "description": "Number of healthy pods.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "desired_healthy": {
+                    "description": "Number of healthy pods the budget requires.",
+                    "type": "integer",
+                    "example": 2
+                },
+                "disruptions_allowed": {
+                    "description": "Number of pods which can be evicted now.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "expected_pods": {
+                    "description": "Number of pods the budget counts with.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "max_unavailable": {
+                    "description": "Pods which can be unavailable, empty when min_available is used.",
+                    "type": "string",
+                    "example": "25%"
+                },
+                "min_available": {
+                    "description": "Pods which have to stay available, empty when max_unavailable is used.",
+                    "type": "string",
+                    "example": "1"
+                },
+                "name": {
+                    "description": "The name of the pod disruption budget.",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "The namespace of the pod disruption budget.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "selector": {
+                    "description": "Label query of the protected pods.",
+                    "type": "string",
+                    "example": "app=nginx"
+                }
+            }
+        },
+        "models.ListPersistentVolumeClaimsResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "persistent_volume_claims": {
+                    "description": "A list of ListPersistentVolumeClaimsResponseModelClaim containing claims data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListPersistentVolumeClaimsResponseModelClaim"
+                    }
+                }
+            }
+        },
+        "models.ListPersistentVolumeClaimsResponseModelClaim": {
+            "type": "object",
+            "properties": {
+                "access_modes": {
+                    "description": "Access modes of the claim.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ReadWriteOnce"
+                    ]
+                },
+                "capacity": {
+                    "description": "Actual capacity of the bound volume.",
+                    "type": "string",
+                    "example": "10Gi"
+                },
+                "creation_time": {
+                    "description": "The creation time of the claim.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "mounted_by": {
+                    "description": "Names of the pods mounting the claim.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "postgres-0"
+                    ]
+                },
+                "name": {
+                    "description": "The name of the claim.",
+                    "type": "string",
+                    "example": "data"
+                },
+                "namespace": {
+                    "description": "The namespace of the claim.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "requested": {
+                    "description": "Requested size of the claim.",
+                    "type": "string",
+                    "example": "10Gi"
+                },
+                "status": {
+                    "description": "The phase of the claim (Pending, Bound or Lost).",
+                    "type": "string",
+                    "example": "Bound"
+                },
+                "storage_class": {
+                    "description": "The StorageClass of the claim.",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "volume": {
+                    "description": "The name of the bound persistent volume.",
+                    "type": "string",
+                    "example": "pvc-0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+                }
+            }
+        },
+        "models.ListPersistentVolumesResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "persistent_volumes": {
+                    "description": "A list of ListPersistentVolumesResponseModelVolume containing volumes data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListPersistentVolumesResponseModelVolume"
+                    }
+                }
+            }
+        },
+        "models.ListPersistentVolumesResponseModelVolume": {
+            "type": "object",
+            "properties": {
+                "access_modes": {
+                    "description": "Access modes of the volume.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ReadWriteOnce"
+                    ]
+                },
+                "capacity": {
+                    "description": "Capacity of the volume.",
+                    "type": "string",
+                    "example": "10Gi"
+                },
+                "claim": {
+                    "description": "The claim bound to the volume in namespace/name format.",
+                    "type": "string",
+                    "example": "default/data"
+                },
+                "creation_time": {
+                    "description": "The creation time of the volume.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "name": {
+                    "description": "The name of the volume.",
+                    "type": "string",
+                    "example": "pvc-0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+                },
+                "reclaim_policy": {
+                    "description": "What happens with the volume when it's released from its claim.",
+                    "type": "string",
+                    "example": "Delete"
+                },
+                "status": {
+                    "description": "The phase of the volume (Available, Bound, Released or Failed).",
+                    "type": "string",
+                    "example": "Bound"
+                },
+                "storage_class": {
+                    "description": "The StorageClass of the volume.",
+                    "type": "string",
+                    "example": "standard"
+                }
+            }
+        },
+        "models.ListPodsV2ResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "pods": {
+                    "description": "A slice of ListPodsV2ResponseModelPod objects representing the pods.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListPodsV2ResponseModelPod"
+                    }
+                }
+            }
+        },
+        "models.ListPodsV2ResponseModelPod": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The name of the pod.",
+                    "type": "string",
+                    "example": "nginx-deploy-59849dcb58-tdknv"
+                },
+                "namespace": {
+                    "description": "The namespace of the pod.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "status": {
+                    "description": "The status of the pod.",
+                    "type": "string",
+                    "example": "Running"
+                }
+            }
+        },
+        "models.ListResourceQuotasResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "resource_quotas": {
+                    "description": "A list of ListResourceQuotasResponseModelQuota containing resource quotas data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListResourceQuotasResponseModelQuota"
+                    }
+                }
+            }
+        },
+        "models.ListResourceQuotasResponseModelQuota": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The name of the resource quota.",
+                    "type": "string",
+                    "example": "compute"
+                },
+                "namespace": {
+                    "description": "The namespace of the resource quota.",
+                    "type": "string",
+                    "example": "payments"
+                },
+                "usage": {
+                    "description": "Usage against hard limits by resource.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ResourceQuotaUsageModel"
+                    }
+                }
+            }
+        },
+        "models.ListResourcesResponseModel": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "Columns of the table as defined by the server (additionalPrinterColumns for CRDs).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ResourceTableColumnModel"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "rows": {
+                    "description": "Rows of the table, one per object.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ResourceTableRowModel"
+                    }
+                }
+            }
+        },
+        "models.ListRoleBindingsResponseModel": {
+            "type": "object",
+            "properties": {
+                "bindings": {
+                    "description": "A list of ListRoleBindingsResponseModelBinding containing role bindings or cluster role bindings data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListRoleBindingsResponseModelBinding"
+                    }
+                },
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ListRoleBindingsResponseModelBinding": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The name of the binding.",
+                    "type": "string",
+                    "example": "read-pods"
+                },
+                "namespace": {
+                    "description": "The namespace of the binding, empty for cluster role bindings.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "role_kind": {
+                    "description": "Kind of the bound role (Role or ClusterRole).",
+                    "type": "string",
+                    "example": "ClusterRole"
+                },
+                "role_name": {
+                    "description": "The name of the bound role.",
+                    "type": "string",
+                    "example": "pod-reader"
+                },
+                "subjects": {
+                    "description": "Subjects granted the role.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RBACSubjectModel"
+                    }
+                }
+            }
+        },
+        "models.ListRolesResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "roles": {
+                    "description": "A list of ListRolesResponseModelRole containing roles or cluster roles data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListRolesResponseModelRole"
+                    }
+                }
+            }
+        },
+        "models.ListRolesResponseModelRole": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The name of the role.",
+                    "type": "string",
+                    "example": "pod-reader"
+                },
+                "namespace": {
+                    "description": "The namespace of the role, empty for cluster roles.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "rules": {
+                    "description": "Rules of the role, including rules aggregated into cluster roles.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RBACRuleModel"
+                    }
+                }
+            }
+        },
+        "models.ListServiceAccountsResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "service_accounts": {
+                    "description": "A list of ListServiceAccountsResponseModelServiceAccount containing service accounts data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListServiceAccountsResponseModelServiceAccount"
+                    }
+                }
+            }
+        },
+        "models.ListServiceAccountsResponseModelServiceAccount": {
+            "type": "object",
+            "properties": {
+                "automount_token": {
+                    "description": "Whether the API token is mounted into pods, set only when the service account decides it.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "image_pull_secrets": {
+                    "description": "Names of the image pull secrets of the service account.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "registry"
+                    ]
+                },
+                "name": {
+                    "description": "The name of the service account.",
+                    "type": "string",
+                    "example": "builder"
+                },
+                "namespace": {
+                    "description": "The namespace of the service account.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "secrets": {
+                    "description": "Names of the secrets referenced by the service account.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "builder-token"
+                    ]
+                }
+            }
+        },
+        "models.ListServicesResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "services": {
+                    "description": "A list of ListServicesResponseModelService containing services data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListServicesResponseModelService"
+                    }
+                }
+            }
+        },
+        "models.ListServicesResponseModelPort": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The name of the port.",
+                    "type": "string",
+                    "example": "myport"
+                },
+                "node_port": {
+                    "description": "The node port number for the service. Optional.",
+                    "type": "integer",
+                    "example": 30030
+                },
+                "port": {
+                    "description": "The service port number. Optional.",
+                    "type": "integer",
+                    "example": 8080
+                },
+                "protocol": {
+                    "description": "The protocol used by the port. Optional.",
+                    "type": "string",
+                    "example": "TCP"
+                },
+                "target_port": {
+                    "description": "The target port number for the service. Optional.",
+                    "type": "integer",
+                    "example": 8080
+                }
+            }
+        },
+        "models.ListServicesResponseModelService": {
+            "type": "object",
+            "properties": {
+                "cluster_ips": {
+                    "description": "The cluster IP addresses assigned to the service.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "10.1.2.30",
+                        "10.2.2.30"
+                    ]
+                },
+                "name": {
+                    "description": "The name of the service.",
+                    "type": "string",
+                    "example": "myservice"
+                },
+                "namespace": {
+                    "description": "The namespace of the service.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "ports": {
+                    "description": "A slice of ListServicesResponseModelPort objects representing port mappings for the service.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListServicesResponseModelPort"
+                    }
+                },
+                "selector": {
+                    "description": "A map of key-value pairs used to select pods for the service.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"app\"": " \"nginx\"}"
+                    }
+                },
+                "type": {
+                    "description": "The type of the service.",
+                    "type": "string",
+                    "example": "NodePort"
+                }
+            }
+        },
+        "models.ListSnapshotsResponseModel": {
+            "type": "object",
+            "properties": {
+                "snapshots": {
+                    "description": "Snapshots, most recent first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SnapshotModel"
+                    }
+                }
+            }
+        },
+        "models.ListStorageClassesResponseModel": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "description": "Pagination of the list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PaginationModel"
+                        }
+                    ]
+                },
+                "storage_classes": {
+                    "description": "A list of ListStorageClassesResponseModelClass containing storage classes data.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ListStorageClassesResponseModelClass"
+                    }
+                }
+            }
+        },
+        "models.ListStorageClassesResponseModelClass": {
+            "type": "object",
+            "properties": {
+                "allow_volume_expansion": {
+                    "description": "Whether claims of the class can be expanded.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "default": {
+                    "description": "Whether the class is the cluster default.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "description": "The name of the StorageClass.",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "provisioner": {
+                    "description": "The provisioner creating volumes of the class.",
+                    "type": "string",
+                    "example": "rancher.io/local-path"
+                },
+                "reclaim_policy": {
+                    "description": "Reclaim policy of volumes created by the class.",
+                    "type": "string",
+                    "example": "Delete"
+                },
+                "volume_binding_mode": {
+                    "description": "When volumes are bound (Immediate or WaitForFirstConsumer).",
+                    "type": "string",
+                    "example": "WaitForFirstConsumer"
+                }
+            }
+        },
+        "models.NetworkPolicyModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "egress": {
+                    "description": "Rules of allowed outgoing connections, none allowed when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkPolicyRuleModel"
+                    }
+                },
+                "ingress": {
+                    "description": "Rules of allowed incoming connections, none allowed when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkPolicyRuleModel"
+                    }
+                },
+                "name": {
+                    "description": "Name of the network policy",
+                    "type": "string",
+                    "example": "nginx-ingress"
+                },
+                "namespace": {
+                    "description": "Namespace of the network policy",
+                    "type": "string",
+                    "example": "default"
                 },
-                "replicas": {
-                    "description": "Number of replicas for the deployment",
+                "pod_selector": {
+                    "description": "Labels of the pods the policy applies to, all pods of the namespace when empty",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"app\"": " \"nginx\"}"
+                    }
+                },
+                "policy_types": {
+                    "description": "Directions the policy isolates (Ingress, Egress), ingress and egress when there are egress rules by default",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Ingress"
+                    ]
+                }
+            }
+        },
+        "models.NetworkPolicyPeerModel": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "description": "IP range of the peer, can't be used with selectors",
+                    "type": "string",
+                    "example": "10.0.0.0/8"
+                },
+                "except": {
+                    "description": "IP ranges excluded from the cidr",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "10.1.0.0/16"
+                    ]
+                },
+                "namespace_selector": {
+                    "description": "Labels of the namespaces of the selected pods, {} for all namespaces",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"team\"": " \"payments\"}"
+                    }
+                },
+                "pod_selector": {
+                    "description": "Labels of the selected pods, {} for all pods, in the namespace of the policy unless namespace_selector is set",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"app\"": " \"web\"}"
+                    }
+                }
+            }
+        },
+        "models.NetworkPolicyPortModel": {
+            "type": "object",
+            "properties": {
+                "end_port": {
+                    "description": "Last port of the range starting at port",
                     "type": "integer",
-                    "maximum": 32,
-                    "minimum": 1,
-                    "example": 2
+                    "maximum": 65535,
+                    "minimum": 0,
+                    "example": 8090
+                },
+                "port": {
+                    "description": "Port number or name of the container port, all ports when empty",
+                    "type": "string",
+                    "example": "8080"
+                },
+                "protocol": {
+                    "description": "Protocol of the port (default: TCP)",
+                    "type": "string",
+                    "enum": [
+                        "TCP",
+                        "UDP",
+                        "SCTP"
+                    ],
+                    "example": "TCP"
+                }
+            }
+        },
+        "models.NetworkPolicyRuleModel": {
+            "type": "object",
+            "properties": {
+                "peers": {
+                    "description": "Allowed sources (ingress) or destinations (egress), everyone when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkPolicyPeerModel"
+                    }
+                },
+                "ports": {
+                    "description": "Allowed ports, all ports when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkPolicyPortModel"
+                    }
+                }
+            }
+        },
+        "models.NetworkPolicyRuleSummaryModel": {
+            "type": "object",
+            "properties": {
+                "peers": {
+                    "description": "Allowed peers, ex. \"pods app=web in namespaces team=payments\" or \"ip 10.0.0.0/8\", everyone when empty.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "pods app=web"
+                    ]
+                },
+                "ports": {
+                    "description": "Allowed ports as protocol/port, ex. \"TCP/8080\" or \"TCP/8000-8090\", all ports when empty.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "TCP/8080"
+                    ]
+                }
+            }
+        },
+        "models.NetworkPolicyVerdictModel": {
+            "type": "object",
+            "properties": {
+                "allowed": {
+                    "description": "Whether policies of the direction allow the connection.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "allowed_by": {
+                    "description": "Policies with a rule allowing the connection.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "nginx-ingress"
+                    ]
+                },
+                "isolated": {
+                    "description": "Whether any policy of the direction selects the pod, without it everything is allowed.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "policies": {
+                    "description": "Policies of the direction selecting the pod.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "default-deny",
+                        "nginx-ingress"
+                    ]
+                },
+                "reason": {
+                    "description": "Human readable explanation of the verdict.",
+                    "type": "string",
+                    "example": "allowed by nginx-ingress"
+                }
+            }
+        },
+        "models.NodeConditionModel": {
+            "type": "object",
+            "properties": {
+                "last_transition_time": {
+                    "description": "The time of the last transition.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "message": {
+                    "description": "Human readable details of the last transition.",
+                    "type": "string",
+                    "example": "kubelet has sufficient memory available"
+                },
+                "reason": {
+                    "description": "Machine readable reason of the last transition.",
+                    "type": "string",
+                    "example": "KubeletHasSufficientMemory"
+                },
+                "status": {
+                    "description": "Status of the condition (True, False or Unknown).",
+                    "type": "string",
+                    "example": "False"
+                },
+                "type": {
+                    "description": "Type of the condition.",
+                    "type": "string",
+                    "example": "MemoryPressure"
+                }
+            }
+        },
+        "models.NodeResourcesModel": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "description": "CPU amount in k8s format.",
+                    "type": "string",
+                    "example": "4"
+                },
+                "memory": {
+                    "description": "Memory amount in k8s format.",
+                    "type": "string",
+                    "example": "16318368Ki"
+                },
+                "pods": {
+                    "description": "Number of pods, not set for requested resources.",
+                    "type": "string",
+                    "example": "110"
+                }
+            }
+        },
+        "models.NodeTaintModel": {
+            "type": "object",
+            "properties": {
+                "effect": {
+                    "description": "The effect on pods not tolerating the taint.",
+                    "type": "string",
+                    "example": "NoSchedule"
+                },
+                "key": {
+                    "description": "The taint key.",
+                    "type": "string",
+                    "example": "node-role.kubernetes.io/control-plane"
+                },
+                "value": {
+                    "description": "The taint value.",
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "models.OperationItemModel": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Details of the progress.",
+                    "type": "string",
+                    "example": "evicted"
+                },
+                "name": {
+                    "description": "The name of the object the item is about.",
+                    "type": "string",
+                    "example": "nginx-deploy-59849dcb58-tdknv"
+                },
+                "namespace": {
+                    "description": "The namespace of the object.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "status": {
+                    "description": "Progress of the item (Pending, Running, Succeeded, Skipped or Failed).",
+                    "type": "string",
+                    "example": "Succeeded"
+                }
+            }
+        },
+        "models.OperationModel": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "Name of the cluster the operation runs in.",
+                    "type": "string",
+                    "example": "production"
+                },
+                "end_time": {
+                    "description": "The end time of the operation, empty while running.",
+                    "type": "string",
+                    "example": "2024-08-24T20:01:00.000Z"
+                },
+                "error": {
+                    "description": "Error of failed operation.",
+                    "type": "string",
+                    "example": "timed out waiting for evictions"
+                },
+                "id": {
+                    "description": "Identifier of the operation.",
+                    "type": "string",
+                    "example": "5b8c7f0e-3a4d-4e0b-9a52-0c3d6f1e2b7a"
+                },
+                "items": {
+                    "description": "Progress of the operation per object.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OperationItemModel"
+                    }
+                },
+                "kind": {
+                    "description": "Kind of the operation.",
+                    "type": "string",
+                    "example": "drain"
+                },
+                "start_time": {
+                    "description": "The start time of the operation.",
+                    "type": "string",
+                    "example": "2024-08-24T20:00:00.000Z"
+                },
+                "status": {
+                    "description": "State of the operation (Running, Succeeded or Failed).",
+                    "type": "string",
+                    "example": "Running"
+                },
+                "target": {
+                    "description": "The object the operation works on.",
+                    "type": "string",
+                    "example": "node/worker-1"
+                }
+            }
+        },
+        "models.PDBModel": {
+            "type": "object",
+            "required": [
+                "name",
+                "namespace",
+                "selector"
+            ],
+            "properties": {
+                "max_unavailable": {
+                    "description": "Pods which can be unavailable, number or percentage, can't be used with min_available",
+                    "type": "string",
+                    "example": "25%"
+                },
+                "min_available": {
+                    "description": "Pods which have to stay available, number or percentage, can't be used with max_unavailable",
+                    "type": "string",
+                    "example": "1"
+                },
+                "name": {
+                    "description": "Name of the pod disruption budget",
+                    "type": "string",
+                    "example": "nginx"
+                },
+                "namespace": {
+                    "description": "Namespace of the pod disruption budget and its pods",
+                    "type": "string",
+                    "example": "default"
+                },
+                "selector": {
+                    "description": "Labels of the pods the budget protects",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"app\"": " \"nginx\"}"
+                    }
+                }
+            }
+        },
+        "models.PaginationModel": {
+            "type": "object",
+            "properties": {
+                "continue": {
+                    "description": "Token to request the next page, empty on the last page.",
+                    "type": "string",
+                    "example": "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9"
+                },
+                "count": {
+                    "description": "Number of items in the page.",
+                    "type": "integer",
+                    "example": 100
+                },
+                "remaining_item_count": {
+                    "description": "Estimated number of objects after the page, set only when the server knows it.",
+                    "type": "integer",
+                    "example": 250
+                }
+            }
+        },
+        "models.RBACGrantModel": {
+            "type": "object",
+            "properties": {
+                "binding_kind": {
+                    "description": "Kind of the binding (RoleBinding or ClusterRoleBinding).",
+                    "type": "string",
+                    "example": "RoleBinding"
+                },
+                "binding_name": {
+                    "description": "The name of the binding.",
+                    "type": "string",
+                    "example": "read-pods"
+                },
+                "namespace": {
+                    "description": "The namespace the grant applies to, empty for cluster wide grants.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "role_kind": {
+                    "description": "Kind of the bound role (Role or ClusterRole).",
+                    "type": "string",
+                    "example": "ClusterRole"
+                },
+                "role_name": {
+                    "description": "The name of the bound role.",
+                    "type": "string",
+                    "example": "pod-reader"
                 }
             }
         },
-        "models.CreateServiceRequestModel": {
+        "models.RBACRuleModel": {
             "type": "object",
-            "required": [
-                "name",
-                "namespace",
-                "port",
-                "selector",
-                "type"
-            ],
             "properties": {
-                "external_ips": {
-                    "description": "List of external IPs to expose the service on (not used yet)",
+                "api_groups": {
+                    "description": "API groups of the resources, \"\" for the core group and \"*\" for all.",
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "10.1.2.30",
-                        "10.2.2.30"
+                        ""
+                    ]
+                },
+                "non_resource_urls": {
+                    "description": "Allowed non resource URLs (ex. /healthz), set only in cluster roles.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "/healthz"
+                    ]
+                },
+                "resource_names": {
+                    "description": "Names of the allowed objects, all objects when empty.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "nginx"
+                    ]
+                },
+                "resources": {
+                    "description": "Resources and subresources (ex. pods/log), \"*\" for all.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "pods"
+                    ]
+                },
+                "verbs": {
+                    "description": "Allowed verbs, \"*\" for all.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "get",
+                        "list",
+                        "watch"
                     ]
+                }
+            }
+        },
+        "models.RBACSubjectModel": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "Kind of the subject (User, Group or ServiceAccount).",
+                    "type": "string",
+                    "example": "ServiceAccount"
                 },
                 "name": {
-                    "description": "Name for the service",
+                    "description": "The name of the subject.",
                     "type": "string",
-                    "example": "nginx-service"
+                    "example": "builder"
                 },
                 "namespace": {
-                    "description": "Namespace for the service",
+                    "description": "The namespace of the service account.",
                     "type": "string",
                     "example": "default"
-                },
-                "node_port": {
-                    "description": "External node port for NodePort service type",
-                    "type": "integer",
-                    "maximum": 32767,
-                    "minimum": 30000,
-                    "example": 30080
-                },
-                "port": {
-                    "description": "Port of the service",
-                    "type": "integer",
-                    "maximum": 65535,
-                    "minimum": 1,
-                    "example": 80
-                },
-                "selector": {
-                    "description": "Selector to match pods for the service. To create service for specific deployment use {\"app\": deploymentname}.",
+                }
+            }
+        },
+        "models.ResourceQuotaModel": {
+            "type": "object",
+            "required": [
+                "hard",
+                "name",
+                "namespace"
+            ],
+            "properties": {
+                "hard": {
+                    "description": "Hard limits by resource name in k8s format",
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     },
                     "example": {
-                        "{\"app\"": " \"nginx\"}"
+                        " \"pods\"": " \"20\"}",
+                        "{\"requests.cpu\"": " \"4\""
                     }
                 },
-                "target_port": {
-                    "description": "Target port on the container",
-                    "type": "integer",
-                    "maximum": 65535,
-                    "minimum": 1,
-                    "example": 80
+                "name": {
+                    "description": "Name of the resource quota",
+                    "type": "string",
+                    "example": "compute"
                 },
-                "type": {
-                    "description": "Type of service (ClusterIP or NodePort) - load balancers not yet supported",
+                "namespace": {
+                    "description": "Namespace of the resource quota",
                     "type": "string",
-                    "enum": [
-                        "ClusterIP",
-                        "NodePort"
-                    ]
+                    "example": "payments"
                 }
             }
         },
-        "models.DBClusterMetricsModel": {
+        "models.ResourceQuotaUsageModel": {
             "type": "object",
             "properties": {
-                "pods": {
-                    "description": "Timestamp indicating when the record was created. This is the time when the metrics were collected.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DBPodMetricsModel"
-                    }
+                "hard": {
+                    "description": "Hard limit of the resource.",
+                    "type": "string",
+                    "example": "4"
+                },
+                "resource": {
+                    "description": "The name of the resource.",
+                    "type": "string",
+                    "example": "requests.cpu"
+                },
+                "used": {
+                    "description": "Current usage of the resource.",
+                    "type": "string",
+                    "example": "1500m"
                 }
             }
         },
-        "models.DBContainerMetricsModel": {
+        "models.ResourceTableColumnModel": {
             "type": "object",
             "properties": {
-                "cpu_usage": {
-                    "description": "CPU usage of the container in millicores (1/1000th of a core).",
-                    "type": "integer",
-                    "example": 20
+                "description": {
+                    "description": "Description of the column.",
+                    "type": "string",
+                    "example": "Number of the pod with ready state"
                 },
-                "memory_usage": {
-                    "description": "Memory usage of the container in bytes.",
+                "name": {
+                    "description": "Human readable name of the column.",
+                    "type": "string",
+                    "example": "Ready"
+                },
+                "priority": {
+                    "description": "Columns with priority above 0 are less important and may be hidden.",
                     "type": "integer",
-                    "example": 10485760
+                    "example": 0
                 },
-                "name": {
-                    "description": "Name of the container.",
+                "type": {
+                    "description": "Type of the cells (string, integer, number, boolean or date).",
                     "type": "string",
-                    "example": "nginx"
+                    "example": "string"
                 }
             }
         },
-        "models.DBPodMetricsModel": {
+        "models.ResourceTableRowModel": {
             "type": "object",
             "properties": {
-                "containers": {
-                    "description": "Metrics records grouped by containers.",
+                "cells": {
+                    "description": "Values of the row in order of the columns.",
                     "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DBContainerMetricsModel"
-                    }
+                    "items": {}
                 },
                 "name": {
-                    "description": "Name of the pod.",
+                    "description": "The name of the object.",
                     "type": "string",
-                    "example": "mypod"
+                    "example": "nginx-deployment"
+                },
+                "namespace": {
+                    "description": "The namespace of the object, empty for cluster scoped resources.",
+                    "type": "string",
+                    "example": "default"
+                },
+                "object": {
+                    "description": "The full object as returned by the API server.",
+                    "type": "object",
+                    "additionalProperties": true
                 }
             }
         },
-        "models.DeleteDeploymentRequestModel": {
+        "models.ResourceTreeResponseModel": {
             "type": "object",
-            "required": [
-                "name",
-                "namespace"
-            ],
             "properties": {
-                "name": {
-                    "description": "Name of the deployment to delete",
+                "edges": {
+                    "description": "Ownership relations, from owner to dependent.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GraphEdgeModel"
+                    }
+                },
+                "nodes": {
+                    "description": "Objects of the tree.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GraphNodeModel"
+                    }
+                },
+                "root": {
+                    "description": "ID of the top level owner the tree starts at.",
                     "type": "string",
-                    "example": "mydeployment"
+                    "example": "3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"
                 },
-                "namespace": {
-                    "description": "Namespace of the deployment to delete",
+                "target": {
+                    "description": "ID of the requested object.",
                     "type": "string",
-                    "example": "default"
+                    "example": "9a8b7c6d-5e4f-3a2b-1c0d-e9f8a7b6c5d4"
                 }
             }
         },
-        "models.DeletePodMetricsRequestModel": {
+        "models.RestoreSnapshotRequestModel": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "end_time": {
-                    "description": "End time of metrics to delete in RFC3339 format",
+                "dry_run": {
+                    "description": "Set to \"server\" to only preview the results with server-side dry run",
                     "type": "string",
-                    "example": "2024-08-24T20:30:00.000Z"
+                    "enum": [
+                        "server"
+                    ],
+                    "example": "server"
                 },
-                "start_time": {
-                    "description": "Start time of metrics to delete in RFC3339 format",
-                    "type": "string",
-                    "example": "2024-08-24T20:00:00.000Z"
+                "id": {
+                    "description": "ID of the snapshot",
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "models.DeleteServiceRequestModel": {
+        "models.SearchHitModel": {
             "type": "object",
-            "required": [
-                "name",
-                "namespace"
-            ],
             "properties": {
+                "images": {
+                    "description": "Container images used by the object, empty for kinds without pods.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "nginx:1.27"
+                    ]
+                },
+                "kind": {
+                    "description": "Kind of the object.",
+                    "type": "string",
+                    "example": "Deployment"
+                },
+                "labels": {
+                    "description": "Labels of the object.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "matches": {
+                    "description": "Criteria the object matched: name, label, annotation and image.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "name",
+                        "image"
+                    ]
+                },
                 "name": {
-                    "description": "Name of the service to delete",
+                    "description": "The name of the object.",
                     "type": "string",
-                    "example": "myservice"
+                    "example": "nginx-deployment"
                 },
                 "namespace": {
-                    "description": "Namespace of the service to delete",
+                    "description": "The namespace of the object, empty for cluster scoped resources.",
                     "type": "string",
                     "example": "default"
                 }
             }
         },
-        "models.ListContainersReponseModel": {
+        "models.SearchResponseModel": {
             "type": "object",
             "properties": {
-                "containers": {
-                    "description": "A list of ListContainersReponseModelContainer objects representing the containers.",
+                "hits": {
+                    "description": "Matching objects ordered by kind, namespace and name.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ListContainersReponseModelContainer"
+                        "$ref": "#/definitions/models.SearchHitModel"
                     }
+                },
+                "total": {
+                    "description": "Number of all matching objects, hits are limited.",
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "models.ListContainersReponseModelContainer": {
+        "models.SnapshotModel": {
             "type": "object",
             "properties": {
-                "image": {
-                    "description": "The image used by the container.",
+                "cluster": {
+                    "description": "Name of the cluster the snapshot was taken in.",
                     "type": "string",
-                    "example": "nginx"
+                    "example": "production"
                 },
-                "name": {
-                    "description": "The name of the container.",
+                "created_at": {
+                    "description": "The time the snapshot was taken.",
                     "type": "string",
-                    "example": "nginx-deployment"
+                    "example": "2024-08-24T20:00:00Z"
+                },
+                "id": {
+                    "description": "ID of the snapshot.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "label": {
+                    "description": "Label given to the snapshot.",
+                    "type": "string",
+                    "example": "before-migration"
                 },
                 "namespace": {
-                    "description": "The namespace of the container.",
+                    "description": "Namespace the snapshot was taken of.",
                     "type": "string",
                     "example": "default"
                 },
-                "ports": {
-                    "description": "A list of ListContainersReponseModelPort objects representing port mappings for the container.",
+                "object_count": {
+                    "description": "Number of objects in the snapshot.",
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "models.SnapshotObjectRefModel": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "Kind of the object.",
+                    "type": "string",
+                    "example": "ConfigMap"
+                },
+                "name": {
+                    "description": "The name of the object.",
+                    "type": "string",
+                    "example": "app-config"
+                }
+            }
+        },
+        "models.SubjectPermissionsResponseModel": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "description": "Groups of the subject considered, including groups every service account and authenticated user has.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ListContainersReponseModelPort"
+                        "type": "string"
+                    },
+                    "example": [
+                        "system:serviceaccounts",
+                        "system:authenticated"
+                    ]
+                },
+                "permissions": {
+                    "description": "Permissions granted to the subject by the bindings, cluster wide ones first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubjectPermissionsResponseModelPermission"
                     }
+                }
+            }
+        },
+        "models.SubjectPermissionsResponseModelPermission": {
+            "type": "object",
+            "properties": {
+                "bound_subject": {
+                    "description": "Subject of the binding matching the requested subject, ex. one of its groups.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.RBACSubjectModel"
+                        }
+                    ]
                 },
-                "resources": {
-                    "description": "Resource limits and requests for the container.",
+                "grant": {
+                    "description": "Binding granting the rules.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/models.ListContainersReponseModelResources"
+                            "$ref": "#/definitions/models.RBACGrantModel"
                         }
                     ]
+                },
+                "rules": {
+                    "description": "Rules of the bound role.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RBACRuleModel"
+                    }
                 }
             }
         },
-        "models.ListContainersReponseModelPort": {
+        "models.TopologyResponseModel": {
             "type": "object",
             "properties": {
-                "container_port": {
-                    "description": "The container port number.",
-                    "type": "integer",
-                    "example": 9512
-                },
-                "name": {
-                    "description": "The name of the port.",
-                    "type": "string",
-                    "example": "myport"
+                "edges": {
+                    "description": "Relations between the objects.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GraphEdgeModel"
+                    }
                 },
-                "protocol": {
-                    "description": "The protocol used by the port.",
-                    "type": "string",
-                    "example": "TCP"
+                "nodes": {
+                    "description": "Objects of the namespace.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GraphNodeModel"
+                    }
                 }
             }
         },
-        "models.ListContainersReponseModelResources": {
+        "models.UpdateDeploymentRequestModel": {
             "type": "object",
+            "required": [
+                "name",
+                "namespace"
+            ],
             "properties": {
                 "cpu_limit": {
-                    "description": "The CPU limit for the container (e.g., \"200m\").",
+                    "description": "CPU limit for each pod in the deployment",
                     "type": "string",
                     "example": "200m"
                 },
                 "cpu_request": {
-                    "description": "The CPU request for the container (e.g., \"100m\").",
+                    "description": "CPU request for each pod in the deployment",
                     "type": "string",
                     "example": "100m"
                 },
+                "diff": {
+                    "description": "Only preview the changes with server-side dry run and return their diff",
+                    "type": "boolean",
+                    "example": false
+                },
                 "memory_limit": {
-                    "description": "The memory limit for the container (e.g., \"1Gi\").",
+                    "description": "Memory limit for each pod in the deployment",
                     "type": "string",
-                    "example": "1Gi"
+                    "example": "512Mi"
                 },
                 "memory_request": {
-                    "description": "The memory request for the container (e.g., \"512Mi\").",
+                    "description": "Memory request for each pod in the deployment",
                     "type": "string",
-                    "example": "512Mi"
+                    "example": "256Mi"
+                },
+                "name": {
+                    "description": "Name for the deployment",
+                    "type": "string",
+                    "example": "mydeployment"
+                },
+                "namespace": {
+                    "description": "Namespace for the deployment",
+                    "type": "string",
+                    "example": "default"
+                },
+                "replicas": {
+                    "description": "Number of replicas for the deployment",
+                    "type": "integer",
+                    "maximum": 32,
+                    "minimum": 0,
+                    "example": 2
                 }
             }
         },
-        "models.ListDeploymentsResponseModel": {
+        "models.UpdateDeploymentResponseModel": {
             "type": "object",
             "properties": {
-                "deployments": {
-                    "description": "A slice of ListDeploymentsResponseModelDeployment objects representing the deployments.",
+                "status": {
+                    "description": "Status of the request.",
+                    "type": "string",
+                    "example": "deployment updated"
+                },
+                "warnings": {
+                    "description": "Warnings about the update, ex. replicas managed by an autoscaler.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ListDeploymentsResponseModelDeployment"
+                        "type": "string"
                     }
                 }
             }
         },
-        "models.ListDeploymentsResponseModelDeployment": {
+        "models.UpdateIngressRequestModel": {
             "type": "object",
+            "required": [
+                "name",
+                "namespace",
+                "rules"
+            ],
             "properties": {
-                "creation_time": {
-                    "description": "The creation time of the deployment.",
+                "ingress_class": {
+                    "description": "Name of the IngressClass handling the ingress, cluster default is used when empty",
                     "type": "string",
-                    "example": "2024-08-24T20:00:00.000Z"
+                    "example": "nginx"
                 },
                 "name": {
-                    "description": "The name of the deployment.",
+                    "description": "Name of the ingress",
                     "type": "string",
-                    "example": "nginx-deployment"
+                    "example": "nginx-ingress"
                 },
                 "namespace": {
-                    "description": "The namespace of the deployment.",
+                    "description": "Namespace of the ingress",
                     "type": "string",
                     "example": "default"
                 },
-                "ready_replicas": {
-                    "description": "The number of ready replicas in the deployment.",
-                    "type": "integer",
-                    "example": 3
-                },
-                "replicas": {
-                    "description": "The number of replicas in the deployment.",
-                    "type": "integer",
-                    "example": 3
-                },
-                "unavailable_replicas": {
-                    "description": "The number of unavailable replicas in the deployment.",
-                    "type": "integer",
-                    "example": 0
+                "rules": {
+                    "description": "Routing rules replacing the current ones",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.IngressRuleModel"
+                    }
                 },
-                "updated_replicas": {
-                    "description": "The number of updated replicas in the deployment.",
-                    "type": "integer",
-                    "example": 3
+                "tls": {
+                    "description": "TLS configuration replacing the current one",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngressTLSModel"
+                    }
                 }
             }
         },
-        "models.ListPodsV2ResponseModel": {
+        "models.UpdateNamespaceRequestModel": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "pods": {
-                    "description": "A slice of ListPodsV2ResponseModelPod objects representing the pods.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ListPodsV2ResponseModelPod"
+                "annotations": {
+                    "description": "Annotations replacing the current ones, current annotations are kept when not set",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"owner\"": " \"john\"}"
+                    }
+                },
+                "labels": {
+                    "description": "Labels replacing the current ones, current labels are kept when not set",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "{\"team\"": " \"payments\"}"
                     }
+                },
+                "name": {
+                    "description": "Name of the namespace",
+                    "type": "string",
+                    "example": "payments"
                 }
             }
         },
-        "models.ListPodsV2ResponseModelPod": {
+        "models.WatchEventModel": {
             "type": "object",
             "properties": {
-                "name": {
-                    "description": "The name of the pod.",
+                "kind": {
+                    "description": "Kind of the object, one of Pod, Deployment, Service and Event.",
                     "type": "string",
-                    "example": "nginx-deploy-59849dcb58-tdknv"
+                    "example": "Pod"
                 },
                 "namespace": {
-                    "description": "The namespace of the pod.",
+                    "description": "The namespace of the object.",
                     "type": "string",
                     "example": "default"
                 },
-                "status": {
-                    "description": "The status of the pod.",
+                "object": {
+                    "description": "Object summarized the same way as by the list endpoints (ex. ListPodsV2ResponseModelPod)."
+                },
+                "resource_version": {
+                    "description": "Version of the change, send it back as resource_version (or Last-Event-ID) to resume.",
+                    "type": "integer",
+                    "example": 1024
+                },
+                "type": {
+                    "description": "Type of the change, one of ADDED, MODIFIED and DELETED.",
                     "type": "string",
-                    "example": "Running"
+                    "example": "MODIFIED"
                 }
             }
         },
-        "models.ListServicesResponseModel": {
+        "models.WhoCanResponseModel": {
             "type": "object",
             "properties": {
-                "services": {
-                    "description": "A list of ListServicesResponseModelService containing services data.",
+                "subjects": {
+                    "description": "Subjects bound to a role allowing the request, sorted by kind, namespace and name.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ListServicesResponseModelService"
+                        "$ref": "#/definitions/models.WhoCanResponseModelSubject"
                     }
                 }
             }
         },
-        "models.ListServicesResponseModelPort": {
+        "models.WhoCanResponseModelSubject": {
             "type": "object",
             "properties": {
-                "name": {
-                    "description": "The name of the port.",
-                    "type": "string",
-                    "example": "myport"
-                },
-                "node_port": {
-                    "description": "The node port number for the service. Optional.",
-                    "type": "integer",
-                    "example": 30030
+                "granted_by": {
+                    "description": "Bindings granting the subject the request.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RBACGrantModel"
+                    }
                 },
-                "port": {
-                    "description": "The service port number. Optional.",
-                    "type": "integer",
-                    "example": 8080
+                "kind": {
+                    "description": "Kind of the subject (User, Group or ServiceAccount).",
+                    "type": "string",
+                    "example": "ServiceAccount"
                 },
-                "protocol": {
-                    "description": "The protocol used by the port. Optional.",
+                "name": {
+                    "description": "The name of the subject.",
                     "type": "string",
-                    "example": "TCP"
+                    "example": "builder"
                 },
-                "target_port": {
-                    "description": "The target port number for the service. Optional.",
-                    "type": "integer",
-                    "example": 8080
+                "namespace": {
+                    "description": "The namespace of the service account.",
+                    "type": "string",
+                    "example": "default"
                 }
             }
         },
-        "models.ListServicesResponseModelService": {
+        "models.WorkloadMetricsIntervalModel": {
             "type": "object",
             "properties": {
-                "cluster_ips": {
-                    "description": "The cluster IP addresses assigned to the service.",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "10.1.2.30",
-                        "10.2.2.30"
-                    ]
+                "cpu_avg": {
+                    "description": "CPU usage of one pod in millicores, averaged over the pods and collections.",
+                    "type": "integer",
+                    "example": 150
                 },
-                "name": {
-                    "description": "The name of the service.",
-                    "type": "string",
-                    "example": "myservice"
+                "cpu_max": {
+                    "description": "Highest CPU usage of one pod in millicores.",
+                    "type": "integer",
+                    "example": 210
                 },
-                "namespace": {
-                    "description": "The namespace of the service.",
-                    "type": "string",
-                    "example": "default"
+                "cpu_sum": {
+                    "description": "CPU usage of all pods together in millicores, averaged over the collections.",
+                    "type": "integer",
+                    "example": 450
                 },
-                "ports": {
-                    "description": "A slice of ListServicesResponseModelPort objects representing port mappings for the service.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ListServicesResponseModelPort"
-                    }
+                "memory_avg": {
+                    "description": "Memory usage of one pod in bytes, averaged over the pods and collections.",
+                    "type": "integer",
+                    "example": 134217728
                 },
-                "selector": {
-                    "description": "A map of key-value pairs used to select pods for the service.",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    },
-                    "example": {
-                        "{\"app\"": " \"nginx\"}"
-                    }
+                "memory_max": {
+                    "description": "Highest memory usage of one pod in bytes.",
+                    "type": "integer",
+                    "example": 157286400
                 },
-                "type": {
-                    "description": "The type of the service.",
+                "memory_sum": {
+                    "description": "Memory usage of all pods together in bytes, averaged over the collections.",
+                    "type": "integer",
+                    "example": 402653184
+                },
+                "pods": {
+                    "description": "Highest number of pods in one collection.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "samples": {
+                    "description": "Number of metrics collections in the interval.",
+                    "type": "integer",
+                    "example": 12
+                },
+                "start": {
+                    "description": "Start of the interval.",
                     "type": "string",
-                    "example": "NodePort"
+                    "example": "2024-08-24T20:00:00Z"
                 }
             }
         },
-        "models.UpdateDeploymentRequestModel": {
+        "models.WorkloadMetricsModel": {
             "type": "object",
-            "required": [
-                "name",
-                "namespace"
-            ],
             "properties": {
-                "cpu_limit": {
-                    "description": "CPU limit for each pod in the deployment",
-                    "type": "string",
-                    "example": "200m"
-                },
-                "cpu_request": {
-                    "description": "CPU request for each pod in the deployment",
-                    "type": "string",
-                    "example": "100m"
-                },
-                "memory_limit": {
-                    "description": "Memory limit for each pod in the deployment",
-                    "type": "string",
-                    "example": "512Mi"
+                "intervals": {
+                    "description": "Usage in the intervals with collected metrics, oldest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkloadMetricsIntervalModel"
+                    }
                 },
-                "memory_request": {
-                    "description": "Memory request for each pod in the deployment",
+                "kind": {
+                    "description": "Kind of the workload, empty when grouped by namespace.",
                     "type": "string",
-                    "example": "256Mi"
+                    "example": "Deployment"
                 },
                 "name": {
-                    "description": "Name for the deployment",
+                    "description": "The name of the workload, empty when grouped by namespace.",
                     "type": "string",
-                    "example": "mydeployment"
+                    "example": "nginx-deployment"
                 },
                 "namespace": {
-                    "description": "Namespace for the deployment",
+                    "description": "The namespace of the pods.",
                     "type": "string",
                     "example": "default"
-                },
-                "replicas": {
-                    "description": "Number of replicas for the deployment",
-                    "type": "integer",
-                    "maximum": 32,
-                    "minimum": 0,
-                    "example": 2
                 }
             }
         }
@@ -0,0 +1,225 @@
+package httpapi
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	jwtware "github.com/gofiber/contrib/jwt"
+	"github.com/gofiber/fiber/v3"
+	"gorm.io/gorm"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+)
+
+type structValidator struct {
+	validate *validator.Validate
+}
+
+func (v *structValidator) Validate(out any) error {
+
+	err := v.validate.Struct(out)
+	if err == nil {
+		return nil
+	}
+
+	// maybe there was an actual error with validation
+	// in this case also return error
+	if _, ok := err.(*validator.InvalidValidationError); ok {
+		return err
+	}
+
+	// take the first parameter error
+	validationErrors := err.(validator.ValidationErrors)
+	firstErr := validationErrors[0]
+
+	return errors.New(firstErr.StructField())
+
+}
+
+func JWTErrorHandler(c fiber.Ctx, err error) error {
+
+	return c.Status(fiber.StatusUnauthorized).JSON(
+		fiber.Map{"error": err.Error()},
+	)
+}
+
+// prefix of the proxy routes, the only ones reading streamed request bodies
+const proxyPathPrefix = "/api/v1/proxy/"
+
+// streamed request bodies are read to memory for every route except the
+// proxy, bodies bigger than the body limit of the app are rejected
+func limitRequestBody(c fiber.Ctx) error {
+
+	if strings.HasPrefix(c.Path(), proxyPathPrefix) || !c.Request().IsBodyStream() {
+		return c.Next()
+	}
+
+	limit := c.App().Config().BodyLimit
+	body, err := io.ReadAll(io.LimitReader(c.Request().BodyStream(), int64(limit)+1))
+	if err != nil {
+		makeBR(&c, err)
+		return nil
+	}
+	if len(body) > limit {
+		// the rest of the body is never read
+		c.Response().SetConnectionClose()
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(
+			fiber.Map{"error": "request body too large"},
+		)
+	}
+	c.Request().SetBodyRaw(body)
+
+	return c.Next()
+}
+
+// fiber app validating request parameters, request bodies are streamed so
+// proxied uploads aren't buffered, other routes get them limited to the
+// body limit, routes are added with RegisterRoutes
+func NewApp() *fiber.App {
+
+	app := fiber.New(fiber.Config{
+		StructValidator:   &structValidator{validate: validator.New()},
+		StreamRequestBody: true,
+	})
+	app.Use(limitRequestBody)
+
+	return app
+}
+
+// register public routes, then the JWT middleware signed with the server
+// secret key (skipped in dev mode) and restricted routes after it
+func RegisterRoutes(
+	app *fiber.App,
+	clusters *controller.ClusterRegistry,
+	db *gorm.DB,
+	ssk []byte,
+	devMode bool,
+) {
+
+	// Login route
+	app.Post("/api/v1/login", ApiV1Login)
+
+	app.Get("/api/v1/accessible", ApiV1Accessible)
+	app.Get("/api/v1/ready", ApiV1Ready(clusters))
+
+	// JWT Middleware
+	if !devMode {
+		app.Use(jwtware.New(jwtware.Config{
+			SigningKey:   jwtware.SigningKey{Key: ssk},
+			ErrorHandler: JWTErrorHandler,
+		}))
+	}
+
+	// Restricted Routes
+	app.Get("/api/v1/restricted", ApiV1Restricted)
+	app.Get("/api/v1/listclusters", ApiV1ListClusters(clusters))
+	app.Get("/api/v1/listpods", ApiV1ListPods(clusters))
+	app.Get("/api/v2/listpods", ApiV2ListPods(clusters))
+	app.Get("/api/v1/listcontainers", ApiV1ListContainers(clusters))
+	app.Get("/api/v1/listnamespaces", ApiV1ListNamespaces(clusters))
+	app.Get("/api/v2/listnamespaces", ApiV2ListNamespaces(clusters))
+	app.Post("/api/v1/createnamespace", ApiV1CreateNamespace(clusters))
+	app.Post("/api/v1/updatenamespace", ApiV1UpdateNamespace(clusters))
+	app.Post("/api/v1/deletenamespace", ApiV1DeleteNamespace(clusters))
+
+	app.Get("/api/v1/listresourcequotas", ApiV1ListResourceQuotas(clusters))
+	app.Post("/api/v1/createresourcequota", ApiV1CreateResourceQuota(clusters))
+	app.Post("/api/v1/updateresourcequota", ApiV1UpdateResourceQuota(clusters))
+	app.Post("/api/v1/deleteresourcequota", ApiV1DeleteResourceQuota(clusters))
+
+	app.Get("/api/v1/listlimitranges", ApiV1ListLimitRanges(clusters))
+	app.Post("/api/v1/createlimitrange", ApiV1CreateLimitRange(clusters))
+	app.Post("/api/v1/updatelimitrange", ApiV1UpdateLimitRange(clusters))
+	app.Post("/api/v1/deletelimitrange", ApiV1DeleteLimitRange(clusters))
+
+	app.Get("/api/v1/listpdbs", ApiV1ListPDBs(clusters))
+	app.Post("/api/v1/createpdb", ApiV1CreatePDB(clusters))
+	app.Post("/api/v1/updatepdb", ApiV1UpdatePDB(clusters))
+	app.Post("/api/v1/deletepdb", ApiV1DeletePDB(clusters))
+
+	app.Get("/api/v1/listnetworkpolicies", ApiV1ListNetworkPolicies(clusters))
+	app.Post("/api/v1/createnetworkpolicy", ApiV1CreateNetworkPolicy(clusters))
+	app.Post("/api/v1/updatenetworkpolicy", ApiV1UpdateNetworkPolicy(clusters))
+	app.Post("/api/v1/deletenetworkpolicy", ApiV1DeleteNetworkPolicy(clusters))
+	app.Get("/api/v1/evaluatenetworkpolicy", ApiV1EvaluateNetworkPolicy(clusters))
+
+	app.Get("/api/v1/listroles", ApiV1ListRoles(clusters))
+	app.Get("/api/v1/listclusterroles", ApiV1ListClusterRoles(clusters))
+	app.Get("/api/v1/listrolebindings", ApiV1ListRoleBindings(clusters))
+	app.Get("/api/v1/listclusterrolebindings", ApiV1ListClusterRoleBindings(clusters))
+	app.Get("/api/v1/listserviceaccounts", ApiV1ListServiceAccounts(clusters))
+	app.Get("/api/v1/whocan", ApiV1WhoCan(clusters))
+	app.Get("/api/v1/subjectpermissions", ApiV1SubjectPermissions(clusters))
+	app.Post("/api/v1/reviewaccess", ApiV1ReviewAccess(clusters))
+
+	app.Get("/api/v1/listnodes", ApiV1ListNodes(clusters))
+	app.Get("/api/v1/getnode", ApiV1GetNode(clusters))
+	app.Post("/api/v1/cordonnode", ApiV1CordonNode(clusters))
+	app.Post("/api/v1/uncordonnode", ApiV1UncordonNode(clusters))
+	app.Post("/api/v1/drainnode", ApiV1DrainNode(clusters))
+
+	app.Get("/api/v1/getoperation", ApiV1GetOperation(clusters))
+	app.Get("/api/v1/listoperations", ApiV1ListOperations(clusters))
+
+	app.Get("/api/v1/listdeployments", ApiV1ListDeployments(clusters))
+	app.Post("/api/v1/createdeployment", ApiV1CreateDeployment(clusters))
+	app.Post("/api/v1/updatedeployment", ApiV1UpdateDeployment(clusters))
+	app.Post("/api/v1/deletedeployment", ApiV1DeleteDeployment(clusters))
+
+	app.Get("/api/v1/listhpas", ApiV1ListHPAs(clusters))
+	app.Post("/api/v1/createhpa", ApiV1CreateHPA(clusters))
+	app.Post("/api/v1/updatehpa", ApiV1UpdateHPA(clusters))
+	app.Post("/api/v1/deletehpa", ApiV1DeleteHPA(clusters))
+
+	app.Get("/api/v1/getpodmetrics", ApiV1GetPodMetrics(clusters))
+	app.Get("/api/v2/getpodmetrics", ApiV2GetPodMetrics(clusters, db))
+	app.Get("/api/v1/getnodemetrics", ApiV1GetNodeMetrics(clusters, db))
+	app.Get("/api/v1/getworkloadmetrics", ApiV1GetWorkloadMetrics(clusters, db))
+	app.Post("/api/v1/deletepodmetrics", ApiV1DeletePodMetrics(clusters, db))
+
+	app.Post("/api/v1/createservice", ApiV1CreateService(clusters))
+	app.Get("/api/v1/listservices", ApiV1ListServices(clusters))
+	app.Post("/api/v1/deleteservice", ApiV1DeleteService(clusters))
+
+	app.Get("/api/v1/listingresses", ApiV1ListIngresses(clusters))
+	app.Post("/api/v1/createingress", ApiV1CreateIngress(clusters))
+	app.Post("/api/v1/updateingress", ApiV1UpdateIngress(clusters))
+	app.Post("/api/v1/deleteingress", ApiV1DeleteIngress(clusters))
+
+	app.Get("/api/v1/listpersistentvolumeclaims", ApiV1ListPersistentVolumeClaims(clusters))
+	app.Get("/api/v1/listpersistentvolumes", ApiV1ListPersistentVolumes(clusters))
+	app.Get("/api/v1/liststorageclasses", ApiV1ListStorageClasses(clusters))
+	app.Post("/api/v1/createpersistentvolumeclaim", ApiV1CreatePersistentVolumeClaim(clusters))
+	app.Post("/api/v1/expandpersistentvolumeclaim", ApiV1ExpandPersistentVolumeClaim(clusters))
+
+	app.Get("/api/v1/listevents", ApiV1ListEvents(clusters))
+	app.Get("/api/v1/geteventhistory", ApiV1GetEventHistory(clusters, db))
+
+	app.Get("/api/v1/listapiresources", ApiV1ListAPIResources(clusters))
+	app.Get("/api/v1/listresources", ApiV1ListResources(clusters))
+	app.Get("/api/v1/getresource", ApiV1GetResource(clusters))
+	app.Post("/api/v1/deleteresource", ApiV1DeleteResource(clusters))
+	app.Post("/api/v1/applymanifests", ApiV1ApplyManifests(clusters))
+	app.Get("/api/v1/exportresource", ApiV1ExportResource(clusters))
+	app.Get("/api/v1/exportnamespace", ApiV1ExportNamespace(clusters))
+
+	app.Post("/api/v1/createsnapshot", ApiV1CreateSnapshot(clusters, db))
+	app.Get("/api/v1/listsnapshots", ApiV1ListSnapshots(clusters, db))
+	app.Get("/api/v1/diffsnapshot", ApiV1DiffSnapshot(clusters, db))
+	app.Post("/api/v1/restoresnapshot", ApiV1RestoreSnapshot(clusters, db))
+	app.Post("/api/v1/deletesnapshot", ApiV1DeleteSnapshot(clusters, db))
+
+	app.Get("/api/v1/getresourcetree", ApiV1GetResourceTree(clusters))
+	app.Get("/api/v1/gettopology", ApiV1GetTopology(clusters))
+
+	app.Get("/api/v1/search", ApiV1Search(clusters))
+	app.Get("/api/v1/watch", ApiV1Watch(clusters))
+
+	app.Add(
+		[]string{fiber.MethodGet, fiber.MethodPost, fiber.MethodPut, fiber.MethodPatch, fiber.MethodDelete},
+		proxyPathPrefix+":kind/:namespace/:name/:port/*",
+		ApiV1Proxy(clusters),
+	)
+}
@@ -0,0 +1,778 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+	appsapiv1 "k8s.io/api/apps/v1"
+	autoscalingapiv2 "k8s.io/api/autoscaling/v2"
+	coreapiv1 "k8s.io/api/core/v1"
+	networkingapiv1 "k8s.io/api/networking/v1"
+	rbacapiv1 "k8s.io/api/rbac/v1"
+	storageapiv1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakemetadata "k8s.io/client-go/metadata/fake"
+	fakerest "k8s.io/client-go/rest/fake"
+	"k8s.io/client-go/restmapper"
+	k8stesting "k8s.io/client-go/testing"
+	fakemetricsv "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	"github.com/kube-dash/kube-dash-backend/common"
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/database"
+)
+
+const testClusterName = "test"
+
+// routes requested by the tests, every registered /api route
+// has to be among them
+var (
+	requestedRoutes   = map[string]bool{}
+	requestedRoutesMu sync.Mutex
+	registeredRoutes  []string
+)
+
+func TestMain(m *testing.M) {
+
+	dir, err := os.MkdirTemp("", "kubedash-test")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	secretkeyPath := filepath.Join(dir, "secret.key")
+	err = os.WriteFile(secretkeyPath, []byte("test-secret-key"), 0600)
+	if err == nil {
+		err = common.InitSSK(secretkeyPath)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+
+	// coverage is known only when all tests ran
+	if code == 0 && len(requestedRoutes) > 0 && flag.Lookup("test.run").Value.String() == "" {
+		missing := []string{}
+		for _, route := range registeredRoutes {
+			if !requestedRoutes[route] {
+				missing = append(missing, route)
+			}
+		}
+		if len(missing) > 0 {
+			fmt.Println("routes not covered by tests:", strings.Join(missing, ", "))
+			code = 1
+		}
+	}
+
+	os.Exit(code)
+}
+
+type testServer struct {
+	app   *fiber.App
+	db    *gorm.DB
+	token string
+}
+
+func testObjects() []runtime.Object {
+
+	replicas := int32(2)
+	cpuUtilization := int32(70)
+	currentUtilization := int32(95)
+	storageClass := "standard"
+	noStorageClass := ""
+	allowExpansion := true
+	controllerRef := true
+	created := metaapiv1.NewTime(time.Now().Add(-time.Hour))
+
+	return []runtime.Object{
+		&coreapiv1.Namespace{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "default", CreationTimestamp: created},
+			Status:     coreapiv1.NamespaceStatus{Phase: coreapiv1.NamespaceActive},
+		},
+		&coreapiv1.Namespace{
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Name: "payments", Labels: map[string]string{"team": "payments"},
+			},
+			Status: coreapiv1.NamespaceStatus{Phase: coreapiv1.NamespaceActive},
+		},
+		&coreapiv1.Node{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "worker-1", CreationTimestamp: created},
+			Status: coreapiv1.NodeStatus{
+				Conditions: []coreapiv1.NodeCondition{
+					{Type: coreapiv1.NodeReady, Status: coreapiv1.ConditionTrue},
+				},
+				Capacity: coreapiv1.ResourceList{
+					coreapiv1.ResourceCPU:    resource.MustParse("4"),
+					coreapiv1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				Allocatable: coreapiv1.ResourceList{
+					coreapiv1.ResourceCPU:    resource.MustParse("4"),
+					coreapiv1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+			},
+		},
+		&coreapiv1.Node{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "worker-2", CreationTimestamp: created},
+		},
+		&appsapiv1.Deployment{
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Name: "nginx", Namespace: "default", UID: "deployment-uid",
+				Labels: map[string]string{"app": "nginx"}, CreationTimestamp: created,
+			},
+			Spec: appsapiv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metaapiv1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+				Template: coreapiv1.PodTemplateSpec{
+					ObjectMeta: metaapiv1.ObjectMeta{Labels: map[string]string{"app": "nginx"}},
+					Spec: coreapiv1.PodSpec{
+						Containers: []coreapiv1.Container{{Name: "nginx", Image: "nginx:1.27"}},
+					},
+				},
+			},
+			Status: appsapiv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 2},
+		},
+		&appsapiv1.ReplicaSet{
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Name: "nginx-5d8", Namespace: "default", UID: "replicaset-uid",
+				Labels: map[string]string{"app": "nginx"},
+				OwnerReferences: []metaapiv1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx",
+					UID: "deployment-uid", Controller: &controllerRef,
+				}},
+			},
+			Spec: appsapiv1.ReplicaSetSpec{
+				Replicas: &replicas,
+				Selector: &metaapiv1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			},
+		},
+		&coreapiv1.Pod{
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Name: "nginx-5d8-abcde", Namespace: "default", UID: "pod-uid",
+				Labels: map[string]string{"app": "nginx"}, CreationTimestamp: created,
+				OwnerReferences: []metaapiv1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "nginx-5d8",
+					UID: "replicaset-uid", Controller: &controllerRef,
+				}},
+			},
+			Spec: coreapiv1.PodSpec{
+				NodeName:   "worker-1",
+				Containers: []coreapiv1.Container{{Name: "nginx", Image: "nginx:1.27"}},
+				Volumes: []coreapiv1.Volume{{
+					Name: "data",
+					VolumeSource: coreapiv1.VolumeSource{
+						PersistentVolumeClaim: &coreapiv1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+					},
+				}},
+			},
+			Status: coreapiv1.PodStatus{
+				Phase: coreapiv1.PodRunning,
+				ContainerStatuses: []coreapiv1.ContainerStatus{
+					{Name: "nginx", Image: "nginx:1.27", Ready: true, RestartCount: 1},
+				},
+			},
+		},
+		&coreapiv1.Service{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "nginx", Namespace: "default", CreationTimestamp: created},
+			Spec: coreapiv1.ServiceSpec{
+				Type:     coreapiv1.ServiceTypeClusterIP,
+				Selector: map[string]string{"app": "nginx"},
+				Ports:    []coreapiv1.ServicePort{{Port: 80}},
+			},
+		},
+		&networkingapiv1.Ingress{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: networkingapiv1.IngressSpec{
+				Rules: []networkingapiv1.IngressRule{{
+					Host: "nginx.example.com",
+					IngressRuleValue: networkingapiv1.IngressRuleValue{
+						HTTP: &networkingapiv1.HTTPIngressRuleValue{
+							Paths: []networkingapiv1.HTTPIngressPath{{
+								Path: "/",
+								Backend: networkingapiv1.IngressBackend{
+									Service: &networkingapiv1.IngressServiceBackend{
+										Name: "nginx", Port: networkingapiv1.ServiceBackendPort{Number: 80},
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		&coreapiv1.Event{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "nginx-5d8-abcde.1", Namespace: "default", UID: "event-uid"},
+			InvolvedObject: coreapiv1.ObjectReference{
+				Kind: "Pod", Name: "nginx-5d8-abcde", Namespace: "default",
+			},
+			Type: coreapiv1.EventTypeWarning, Reason: "BackOff", Message: "Back-off restarting",
+			LastTimestamp: created,
+		},
+		&coreapiv1.ConfigMap{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "settings", Namespace: "default"},
+			Data:       map[string]string{"mode": "prod"},
+		},
+		&coreapiv1.PersistentVolumeClaim{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "data", Namespace: "default"},
+			Spec: coreapiv1.PersistentVolumeClaimSpec{
+				StorageClassName: &storageClass,
+				AccessModes:      []coreapiv1.PersistentVolumeAccessMode{coreapiv1.ReadWriteOnce},
+				Resources: coreapiv1.VolumeResourceRequirements{
+					Requests: coreapiv1.ResourceList{coreapiv1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+				VolumeName: "pv-data",
+			},
+			Status: coreapiv1.PersistentVolumeClaimStatus{Phase: coreapiv1.ClaimBound},
+		},
+		&coreapiv1.PersistentVolumeClaim{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "static", Namespace: "payments"},
+			Spec: coreapiv1.PersistentVolumeClaimSpec{
+				StorageClassName: &noStorageClass,
+				AccessModes:      []coreapiv1.PersistentVolumeAccessMode{coreapiv1.ReadWriteOnce},
+				Resources: coreapiv1.VolumeResourceRequirements{
+					Requests: coreapiv1.ResourceList{coreapiv1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+			Status: coreapiv1.PersistentVolumeClaimStatus{Phase: coreapiv1.ClaimPending},
+		},
+		&coreapiv1.PersistentVolume{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "pv-data"},
+			Spec: coreapiv1.PersistentVolumeSpec{
+				StorageClassName: storageClass,
+				Capacity:         coreapiv1.ResourceList{coreapiv1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+			Status: coreapiv1.PersistentVolumeStatus{Phase: coreapiv1.VolumeBound},
+		},
+		&storageapiv1.StorageClass{
+			ObjectMeta:           metaapiv1.ObjectMeta{Name: "standard"},
+			Provisioner:          "example.com/standard",
+			AllowVolumeExpansion: &allowExpansion,
+		},
+		&coreapiv1.ResourceQuota{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "compute", Namespace: "payments"},
+			Spec: coreapiv1.ResourceQuotaSpec{
+				Hard: coreapiv1.ResourceList{coreapiv1.ResourcePods: resource.MustParse("20")},
+			},
+		},
+		&coreapiv1.LimitRange{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "defaults", Namespace: "payments"},
+			Spec: coreapiv1.LimitRangeSpec{
+				Limits: []coreapiv1.LimitRangeItem{{
+					Type:    coreapiv1.LimitTypeContainer,
+					Default: coreapiv1.ResourceList{coreapiv1.ResourceCPU: resource.MustParse("500m")},
+				}},
+			},
+		},
+		&autoscalingapiv2.HorizontalPodAutoscaler{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "nginx", Namespace: "default"},
+			Spec: autoscalingapiv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingapiv2.CrossVersionObjectReference{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx",
+				},
+				MinReplicas: &replicas,
+				MaxReplicas: 10,
+				Metrics: []autoscalingapiv2.MetricSpec{{
+					Type: autoscalingapiv2.ResourceMetricSourceType,
+					Resource: &autoscalingapiv2.ResourceMetricSource{
+						Name: coreapiv1.ResourceCPU,
+						Target: autoscalingapiv2.MetricTarget{
+							Type: autoscalingapiv2.UtilizationMetricType, AverageUtilization: &cpuUtilization,
+						},
+					},
+				}},
+			},
+			Status: autoscalingapiv2.HorizontalPodAutoscalerStatus{
+				CurrentReplicas: 2,
+				DesiredReplicas: 3,
+				CurrentMetrics: []autoscalingapiv2.MetricStatus{{
+					Type: autoscalingapiv2.ResourceMetricSourceType,
+					Resource: &autoscalingapiv2.ResourceMetricStatus{
+						Name: coreapiv1.ResourceCPU,
+						Current: autoscalingapiv2.MetricValueStatus{
+							AverageUtilization: &currentUtilization,
+						},
+					},
+				}},
+			},
+		},
+		&coreapiv1.ServiceAccount{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "builder", Namespace: "default"},
+			Secrets:    []coreapiv1.ObjectReference{{Name: "builder-token"}},
+		},
+		&rbacapiv1.ClusterRole{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "pod-reader"},
+			Rules: []rbacapiv1.PolicyRule{{
+				Verbs: []string{"get", "list", "watch"}, APIGroups: []string{""}, Resources: []string{"pods"},
+			}},
+		},
+		&rbacapiv1.ClusterRole{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "cluster-admin"},
+			Rules: []rbacapiv1.PolicyRule{{
+				Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"},
+			}},
+		},
+		&rbacapiv1.ClusterRoleBinding{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "cluster-admin"},
+			RoleRef:    rbacapiv1.RoleRef{Kind: "ClusterRole", Name: "cluster-admin"},
+			Subjects:   []rbacapiv1.Subject{{Kind: rbacapiv1.GroupKind, Name: "system:masters"}},
+		},
+		&rbacapiv1.Role{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "deployer", Namespace: "default"},
+			Rules: []rbacapiv1.PolicyRule{{
+				Verbs: []string{"update", "patch"}, APIGroups: []string{"apps"},
+				Resources: []string{"deployments", "deployments/scale"},
+			}},
+		},
+		&rbacapiv1.RoleBinding{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "read-pods", Namespace: "default"},
+			RoleRef:    rbacapiv1.RoleRef{Kind: "ClusterRole", Name: "pod-reader"},
+			Subjects:   []rbacapiv1.Subject{{Kind: rbacapiv1.UserKind, Name: "jane"}},
+		},
+		&rbacapiv1.RoleBinding{
+			ObjectMeta: metaapiv1.ObjectMeta{Name: "builder-deploys", Namespace: "default"},
+			RoleRef:    rbacapiv1.RoleRef{Kind: "Role", Name: "deployer"},
+			Subjects: []rbacapiv1.Subject{
+				{Kind: rbacapiv1.ServiceAccountKind, Name: "builder", Namespace: "default"},
+			},
+		},
+	}
+}
+
+func apiResource(name string, kind string, namespaced bool) metaapiv1.APIResource {
+	return metaapiv1.APIResource{
+		Name: name, Kind: kind, Namespaced: namespaced,
+		Verbs: metaapiv1.Verbs{"get", "list", "watch", "create", "update", "patch", "delete"},
+	}
+}
+
+// resources known to discovery, all of them have types in the client-go
+// scheme so the fake dynamic client can list them
+var testAPIResources = []*metaapiv1.APIResourceList{
+	{
+		GroupVersion: "v1",
+		APIResources: []metaapiv1.APIResource{
+			apiResource("namespaces", "Namespace", false),
+			apiResource("nodes", "Node", false),
+			apiResource("persistentvolumes", "PersistentVolume", false),
+			apiResource("pods", "Pod", true),
+			apiResource("services", "Service", true),
+			apiResource("configmaps", "ConfigMap", true),
+			apiResource("events", "Event", true),
+			apiResource("persistentvolumeclaims", "PersistentVolumeClaim", true),
+			apiResource("resourcequotas", "ResourceQuota", true),
+			apiResource("limitranges", "LimitRange", true),
+		},
+	},
+	{
+		GroupVersion: "apps/v1",
+		APIResources: []metaapiv1.APIResource{
+			apiResource("deployments", "Deployment", true),
+			apiResource("replicasets", "ReplicaSet", true),
+		},
+	},
+	{
+		GroupVersion: "networking.k8s.io/v1",
+		APIResources: []metaapiv1.APIResource{
+			apiResource("ingresses", "Ingress", true),
+		},
+	},
+	{
+		GroupVersion: "storage.k8s.io/v1",
+		APIResources: []metaapiv1.APIResource{
+			apiResource("storageclasses", "StorageClass", false),
+		},
+	},
+}
+
+func tableResponse(req *http.Request) (*http.Response, error) {
+
+	table := metaapiv1.Table{
+		TypeMeta: metaapiv1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "Table"},
+		ColumnDefinitions: []metaapiv1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Restarts", Type: "string"},
+		},
+		Rows: []metaapiv1.TableRow{
+			{
+				Cells: []interface{}{"nginx-5d8-abcde", "Running", "1"},
+				Object: runtime.RawExtension{
+					Raw: []byte(`{"metadata":{"name":"nginx-5d8-abcde","namespace":"default"}}`),
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(data)),
+	}, nil
+}
+
+// the object tracker can't apply, apply is handled as create or update
+// of the whole object which is enough for the tests
+func applyReactor(client *fakedynamic.FakeDynamicClient) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+
+		patch, ok := action.(k8stesting.PatchActionImpl)
+		if !ok || patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+
+		object := &unstructured.Unstructured{}
+		err := json.Unmarshal(patch.GetPatch(), &object.Object)
+		if err != nil {
+			return true, nil, err
+		}
+		if len(patch.PatchOptions.DryRun) > 0 {
+			return true, object, nil
+		}
+
+		tracker := client.Tracker()
+		gvr := patch.GetResource()
+		_, err = tracker.Get(gvr, patch.GetNamespace(), patch.GetName())
+		if apierrors.IsNotFound(err) {
+			err = tracker.Create(gvr, object, patch.GetNamespace())
+		} else if err == nil {
+			err = tracker.Update(gvr, object, patch.GetNamespace())
+		}
+		if err != nil {
+			return true, nil, err
+		}
+
+		applied, err := tracker.Get(gvr, patch.GetNamespace(), patch.GetName())
+		return true, applied, err
+	}
+}
+
+// metadata client serving the metadata of testObjects
+func newTestMetadataClient(t *testing.T) *fakemetadata.FakeMetadataClient {
+
+	t.Helper()
+
+	objects := []runtime.Object{}
+	for _, object := range testObjects() {
+		kinds, _, err := scheme.Scheme.ObjectKinds(object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		accessor, err := meta.Accessor(object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		objects = append(objects, &metaapiv1.PartialObjectMetadata{
+			TypeMeta: metaapiv1.TypeMeta{
+				APIVersion: kinds[0].GroupVersion().String(), Kind: kinds[0].Kind,
+			},
+			ObjectMeta: metaapiv1.ObjectMeta{
+				Name: accessor.GetName(), Namespace: accessor.GetNamespace(),
+				UID: accessor.GetUID(), Labels: accessor.GetLabels(),
+			},
+		})
+	}
+
+	metadataScheme := fakemetadata.NewTestScheme()
+	metaapiv1.AddMetaToScheme(metadataScheme)
+
+	return fakemetadata.NewSimpleMetadataClient(metadataScheme, objects...)
+}
+
+// server with all routes backed by fake clientsets seeded with testObjects
+// and an in-memory database, requests are made with a token of logged in user
+func newTestServer(t *testing.T) *testServer {
+
+	t.Helper()
+
+	clientset := fakekubernetes.NewSimpleClientset(testObjects()...)
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = testAPIResources
+
+	dynamicclient := fakedynamic.NewSimpleDynamicClient(scheme.Scheme, testObjects()...)
+	dynamicclient.PrependReactor("patch", "*", applyReactor(dynamicclient))
+
+	cachedDiscovery := memory.NewMemCacheClient(clientset.Discovery())
+	tableClient := &fakerest.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Client:               fakerest.CreateHTTPClient(tableResponse),
+	}
+
+	cluster := controller.NewCluster(
+		testClusterName,
+		clientset,
+		fakemetricsv.NewSimpleClientset(),
+		&controller.DynamicClient{
+			Client:    dynamicclient,
+			Discovery: cachedDiscovery,
+			Mapper:    restmapper.NewDeferredDiscoveryRESTMapper(cachedDiscovery),
+			// answers table requests the dynamic client can't make
+			REST: tableClient,
+		},
+	)
+	cluster.Metadata = newTestMetadataClient(t)
+	clusters, err := controller.NewClusterRegistryFromClusters("", cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := newTestDB(t)
+
+	stopCh := make(chan struct{})
+	cluster.Cache.Start(stopCh)
+	t.Cleanup(func() { close(stopCh) })
+	deadline := time.Now().Add(5 * time.Second)
+	for !cluster.Cache.HasSynced() {
+		if time.Now().After(deadline) {
+			t.Fatal("resource cache didn't sync")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	app := NewApp()
+	RegisterRoutes(app, clusters, db, []byte("test-secret-key"), false)
+
+	if registeredRoutes == nil {
+		for _, route := range app.GetRoutes(true) {
+			if strings.HasPrefix(route.Path, "/api/") {
+				registeredRoutes = append(registeredRoutes, route.Method+" "+route.Path)
+			}
+		}
+		sort.Strings(registeredRoutes)
+	}
+
+	s := &testServer{app: app, db: db}
+	s.login(t)
+
+	return s
+}
+
+// every test gets its own database, shared cache keeps it
+// alive across the connections of the pool
+func newTestDB(t *testing.T) *gorm.DB {
+
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.ReplaceAll(t.Name(), "/", "_"))
+	db, err := database.InitDB(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		dbIns, _ := db.DB()
+		_ = dbIns.Close()
+	})
+
+	return db
+}
+
+// server with clusters of the kubeconfig pointing to the backend, which
+// plays the API server, without resource cache, the backend
+// is served over TLS as credentials aren't sent over plain HTTP
+func newKubeconfigTestServer(
+	t *testing.T, backend http.Handler, configure func(clusters *controller.ClusterRegistry),
+) *testServer {
+
+	t.Helper()
+
+	server := httptest.NewTLSServer(backend)
+	t.Cleanup(server.Close)
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	err := os.WriteFile(kubeconfig, []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+users:
+- name: kubedash
+  user:
+    token: kubedash-token
+contexts:
+- name: %s
+  context:
+    cluster: test
+    user: kubedash
+current-context: %s
+`, server.URL, testClusterName, testClusterName)), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clusters, err := controller.NewClusterRegistry(kubeconfig, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if configure != nil {
+		configure(clusters)
+	}
+	db := newTestDB(t)
+
+	app := NewApp()
+	RegisterRoutes(app, clusters, db, []byte("test-secret-key"), false)
+
+	s := &testServer{app: app, db: db}
+	s.login(t)
+
+	return s
+}
+
+func impersonate(clusters *controller.ClusterRegistry) { clusters.Impersonate = true }
+
+func allowProxy(clusters *controller.ClusterRegistry) { clusters.AllowProxy = true }
+
+// token of another user than the one logged in, signed like the login does
+func tokenOf(t *testing.T, user string) string {
+
+	t.Helper()
+
+	ssk, err := common.GetSSK()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"usr": user,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(ssk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func (s *testServer) login(t *testing.T) {
+
+	t.Helper()
+
+	status, body := s.request(t, http.MethodPost, "/api/v1/login", nil, map[string]string{
+		"user": "john", "pass": "doe",
+	})
+	if status != http.StatusOK {
+		t.Fatalf("login failed with %d: %v", status, body)
+	}
+	s.token = body["token"].(string)
+}
+
+// make request with query parameters and JSON body (both can be nil),
+// returns status and the decoded JSON object (nil for other responses)
+func (s *testServer) request(
+	t *testing.T, method string, path string, query url.Values, body interface{},
+) (int, map[string]interface{}) {
+
+	t.Helper()
+
+	resp := s.do(t, method, path, query, body, s.token)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]interface{}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		_ = json.Unmarshal(data, &decoded)
+	}
+
+	return resp.StatusCode, decoded
+}
+
+func (s *testServer) do(
+	t *testing.T, method string, path string, query url.Values, body interface{}, token string,
+) *http.Response {
+
+	t.Helper()
+
+	requestedRoutesMu.Lock()
+	requestedRoutes[method+" "+path] = true
+	requestedRoutesMu.Unlock()
+
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	target := path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req := httptest.NewRequest(method, target, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.app.Test(req, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp
+}
+
+// check status of the request and return the decoded response
+func (s *testServer) expect(
+	t *testing.T, status int, method string, path string, query url.Values, body interface{},
+) map[string]interface{} {
+
+	t.Helper()
+
+	got, decoded := s.request(t, method, path, query, body)
+	if got != status {
+		t.Fatalf("%s %s: expected status %d, got %d: %v", method, path, status, got, decoded)
+	}
+
+	return decoded
+}
+
+// length of the list under the key of the response
+func listLen(t *testing.T, body map[string]interface{}, key string) int {
+
+	t.Helper()
+
+	list, ok := body[key].([]interface{})
+	if !ok {
+		t.Fatalf("response has no list %s: %v", key, body)
+	}
+
+	return len(list)
+}
@@ -0,0 +1,171 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Horizontal Pod Autoscalers
+// @Description    Get horizontal pod autoscalers with their targets, current and desired replicas and current metric values
+// @Tags           Autoscaling
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListHPAsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListHPAsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listhpas [get]
+func ApiV1ListHPAs(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListHPAsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		hpas, err := controller.ListHPAs(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(hpas)
+	}
+}
+
+// @Summary        Create Horizontal Pod Autoscaler
+// @Description    Creates horizontal pod autoscaler (autoscaling/v2) scaling the deployment or statefulset between min and max replicas by CPU and memory utilization targets
+// @Tags           Autoscaling
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.HPAModel   true   "Request Model of Create Horizontal Pod Autoscaler"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createhpa [post]
+func ApiV1CreateHPA(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.HPAModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreateHPA(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "horizontal pod autoscaler created"})
+	}
+}
+
+// @Summary        Update Horizontal Pod Autoscaler
+// @Description    Replaces target, replica bounds and metrics of the horizontal pod autoscaler, metrics other than the given CPU and memory utilization are removed
+// @Tags           Autoscaling
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.HPAModel   true   "Request Model of Update Horizontal Pod Autoscaler"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updatehpa [post]
+func ApiV1UpdateHPA(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.HPAModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdateHPA(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "horizontal pod autoscaler updated"})
+	}
+}
+
+// @Summary        Delete Horizontal Pod Autoscaler
+// @Description    Removes the horizontal pod autoscaler by given name and namespace, the workload keeps its current replicas
+// @Tags           Autoscaling
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteHPARequestModel   true   "Request Model of Delete Horizontal Pod Autoscaler"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deletehpa [post]
+func ApiV1DeleteHPA(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteHPARequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteHPA(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "horizontal pod autoscaler deleted"})
+	}
+}
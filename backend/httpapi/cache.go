@@ -0,0 +1,32 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+)
+
+// @Summary        Readiness Check
+// @Description    Reports whether the resource cache of the cluster finished its initial sync. Until then list endpoints read directly from the API server and search is unavailable.
+// @Tags           Test
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200   {object}  map[string]bool   "Ready"
+// @Failure        404
+// @Failure        503   {object}  map[string]bool   "Not ready"
+// @Router         /api/v1/ready [get]
+func ApiV1Ready(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		// public endpoint, the cache is the same for every user
+		cluster, err := clusters.Get(c.Query("cluster"))
+		if err != nil {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		if !cluster.Cache.HasSynced() {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"ready": false})
+		}
+
+		return c.JSON(fiber.Map{"ready": true})
+	}
+}
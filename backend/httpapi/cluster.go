@@ -0,0 +1,71 @@
+package httpapi
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+)
+
+// name of the user the JWT was issued for, empty when there's
+// no token (dev mode or public endpoints)
+func loggedInUser(c *fiber.Ctx) string {
+
+	token, ok := (*c).Locals("user").(*jwt.Token)
+	if !ok {
+		return ""
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return ""
+	}
+	username, _ := claims["usr"].(string)
+
+	return username
+}
+
+// find the cluster named by the cluster query parameter (the default
+// cluster when it's not set) with requests made as the logged in user
+// when the server impersonates, otherwise make not found (unknown cluster),
+// unauthorized (impersonation without user) or internal error
+func getCluster(c *fiber.Ctx, clusters *controller.ClusterRegistry) (*controller.Cluster, error) {
+
+	cluster, err := clusters.Get((*c).Query("cluster"))
+	if err != nil {
+		(*c).Status(fiber.StatusNotFound).JSON(
+			fiber.Map{"error": err.Error()},
+		)
+		return nil, err
+	}
+
+	cluster, err = clusters.As(cluster, loggedInUser(c))
+	if errors.Is(err, controller.ErrForbidden) {
+		(*c).Status(fiber.StatusUnauthorized).JSON(
+			fiber.Map{"error": err.Error()},
+		)
+		return nil, err
+	}
+	if err != nil {
+		makeISE(c, err)
+	}
+
+	return cluster, err
+
+}
+
+// @Summary        List Clusters
+// @Description    Get all clusters the server is configured with, their Kubernetes version and health. Clusters are selected with the cluster parameter of every other endpoint.
+// @Tags           Clusters
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Success        200                {object}    models.ListClustersResponseModel
+// @Failure        401
+// @Router         /api/v1/listclusters [get]
+func ApiV1ListClusters(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		return c.JSON(controller.ListClusters(clusters))
+	}
+}
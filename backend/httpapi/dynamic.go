@@ -0,0 +1,160 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List API Resources
+// @Description    Get all resource kinds served by the cluster in their preferred version, including CRDs
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200                {object}    models.ListAPIResourcesResponseModel
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listapiresources [get]
+func ApiV1ListAPIResources(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		resources, err := controller.ListAPIResources(cluster.Dynamic)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(resources)
+	}
+}
+
+// @Summary        List Objects Of Any Resource
+// @Description    Get objects of any resource kind as a table with the columns defined by the server (additionalPrinterColumns for CRDs). Every row includes the full object.
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListResourcesRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListResourcesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listresources [get]
+func ApiV1ListResources(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListResourcesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		resources, err := controller.ListResources(cluster.Dynamic, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(resources)
+	}
+}
+
+// @Summary        Get Object Of Any Resource
+// @Description    Get single object of any resource kind as returned by the API server
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.GetResourceRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    object
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/getresource [get]
+func ApiV1GetResource(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetResourceRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		object, err := controller.GetResource(cluster.Dynamic, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(object)
+	}
+}
+
+// @Summary        Delete Object Of Any Resource
+// @Description    Removes single object of any resource kind, dependents are removed in background
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteResourceRequestModel   true   "Request Model of Delete Resource"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deleteresource [post]
+func ApiV1DeleteResource(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteResourceRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteResource(cluster.Dynamic, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "resource deleted"})
+	}
+}
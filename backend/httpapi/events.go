@@ -0,0 +1,117 @@
+package httpapi
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"gorm.io/gorm"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Events
+// @Description    Get current events from the cluster, most recent first. Kubernetes keeps events only for about an hour, use /api/v1/geteventhistory for older ones.
+// @Tags           Events
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListEventsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListEventsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listevents [get]
+func ApiV1ListEvents(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListEventsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		events, err := controller.ListEvents(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(events)
+	}
+}
+
+// @Summary        Get Event History
+// @Description    Get events collected to the database, most recent first. Events are kept for 30 days. The user needs the list verb on events of the namespace (cluster wide without namespace).
+// @Tags           Events
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.GetEventHistoryRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListEventsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/geteventhistory [get]
+func ApiV1GetEventHistory(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetEventHistoryRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		var startTime *time.Time = nil
+		var endTime *time.Time = nil
+
+		if req.StartTime != "" {
+
+			parsedStartTime, err := time.Parse(time.RFC3339, req.StartTime)
+			if err != nil {
+				makeBR(&c, errors.New("unable to parse start_time"))
+				return nil
+			}
+			startTime = &parsedStartTime
+
+		}
+
+		if req.EndTime != "" {
+
+			parsedEndTime, err := time.Parse(time.RFC3339, req.EndTime)
+			if err != nil {
+				makeBR(&c, errors.New("unable to parse end_time"))
+				return nil
+			}
+			endTime = &parsedEndTime
+
+		}
+
+		events, err := controller.GetEventHistory(cluster, db, req, startTime, endTime)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(events)
+	}
+}
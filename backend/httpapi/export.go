@@ -0,0 +1,102 @@
+package httpapi
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        Export Object As YAML
+// @Description    Get single object of any resource kind as YAML manifest without status, namespace and fields assigned by the cluster (uid, resourceVersion, managedFields, clusterIP, ...), ready to be applied elsewhere
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Produce        application/yaml
+// @Param          request   query   models.ExportResourceRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {string}    string
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/exportresource [get]
+func ApiV1ExportResource(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ExportResourceRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		manifest, err := controller.ExportResource(cluster.Dynamic, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		c.Set(fiber.HeaderContentType, "application/yaml")
+		return c.Send(manifest)
+	}
+}
+
+// @Summary        Export Namespace
+// @Description    Download every object of the namespace created by users as YAML manifests in tar.gz or zip archive, one file per object. Objects owned by controllers (ex. pods of deployments) and runtime data like events are left out.
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Produce        application/gzip
+// @Produce        application/zip
+// @Param          request   query   models.ExportNamespaceRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {file}    file
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/exportnamespace [get]
+func ApiV1ExportNamespace(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ExportNamespaceRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		// archive is built in memory so errors can still be reported
+		archive := new(bytes.Buffer)
+		err = controller.ExportNamespace(cluster.Dynamic, req, archive)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		if req.Format == controller.ExportFormatZip {
+			c.Attachment(fmt.Sprintf("%s.zip", req.Namespace))
+			c.Set(fiber.HeaderContentType, "application/zip")
+		} else {
+			c.Attachment(fmt.Sprintf("%s.tar.gz", req.Namespace))
+			c.Set(fiber.HeaderContentType, "application/gzip")
+		}
+		return c.Send(archive.Bytes())
+	}
+}
@@ -0,0 +1,171 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Available Ingresses
+// @Description    Get all ingresses in the cluster together with the addresses assigned by the load balancer
+// @Tags           Ingresses
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListIngressesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListIngressesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listingresses [get]
+func ApiV1ListIngresses(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListIngressesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		ingresses, err := controller.ListIngresses(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(ingresses)
+	}
+}
+
+// @Summary        Create Ingress
+// @Description    Creates ingress routing hosts and paths to services. Referenced services and their ports have to exist in the same namespace.
+// @Tags           Ingresses
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.CreateIngressRequestModel   true   "Request Model of Create Ingress"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createingress [post]
+func ApiV1CreateIngress(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.CreateIngressRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreateIngress(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "ingress created"})
+	}
+}
+
+// @Summary        Update Ingress
+// @Description    Replaces rules, TLS and ingress class of already existing ingress. Referenced services and their ports have to exist in the same namespace.
+// @Tags           Ingresses
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.UpdateIngressRequestModel   true   "Request Model of Update Ingress"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updateingress [post]
+func ApiV1UpdateIngress(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.UpdateIngressRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdateIngress(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "ingress updated"})
+	}
+}
+
+// @Summary        Delete Ingress
+// @Description    Removes the ingress by given name and namespace
+// @Tags           Ingresses
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteIngressRequestModel   true   "Request Model of Delete Ingress"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deleteingress [post]
+func ApiV1DeleteIngress(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteIngressRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteIngress(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "ingress deleted"})
+	}
+}
@@ -0,0 +1,49 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        Apply Manifests
+// @Description    Server-side applies every object of multi document YAML or JSON manifest with kubedash field manager. Use dry_run=server to preview the results without persisting them, diff=true additionally returns the diff of every object against its live state.
+// @Tags           Resources
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.ApplyManifestsRequestModel   true   "Request Model of Apply Manifests"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  models.ApplyManifestsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/applymanifests [post]
+func ApiV1ApplyManifests(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ApplyManifestsRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		resp, err := controller.ApplyManifests(cluster.Dynamic, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(resp)
+	}
+}
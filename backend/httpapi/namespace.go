@@ -0,0 +1,529 @@
+package httpapi
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/common"
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Namespaces With Details
+// @Description    Returns all namespaces with their status, age, labels and resource quota usage
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListNamespacesV2RequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListNamespacesV2ResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v2/listnamespaces [get]
+func ApiV2ListNamespaces(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListNamespacesV2RequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		namespaces, err := controller.ListNamespacesV2(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(namespaces)
+	}
+}
+
+// @Summary        Create Namespace
+// @Description    Creates namespace with the given labels and annotations
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.CreateNamespaceRequestModel   true   "Request Model of Create Namespace"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createnamespace [post]
+func ApiV1CreateNamespace(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.CreateNamespaceRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreateNamespace(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "namespace created"})
+	}
+}
+
+// @Summary        Update Namespace
+// @Description    Replaces labels and annotations of the namespace
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.UpdateNamespaceRequestModel   true   "Request Model of Update Namespace"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updatenamespace [post]
+func ApiV1UpdateNamespace(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.UpdateNamespaceRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdateNamespace(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "namespace updated"})
+	}
+}
+
+// @Summary        Delete Namespace
+// @Description    Deletion is done in two steps. The call without confirmation_token returns 202 with a token valid for 5 minutes, the namespace is deleted only when the same user repeats the call with that token.
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteNamespaceRequestModel   true   "Request Model of Delete Namespace"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  models.DeleteNamespaceResponseModel
+// @Success        202   {object}  models.DeleteNamespaceResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deletenamespace [post]
+func ApiV1DeleteNamespace(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteNamespaceRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		// token confirms deletion only by the user and in the cluster
+		// it was issued for
+		subject := loggedInUser(&c) + "@" + cluster.Name + "/namespace/" + req.Name
+
+		// first step, hand out the token
+		if req.ConfirmationToken == "" {
+			token, expires, err := common.NewConfirmToken(subject)
+			if err != nil {
+				makeISE(&c, err)
+				return nil
+			}
+			return c.Status(fiber.StatusAccepted).JSON(
+				models.DeleteNamespaceResponseModel{
+					Status:            "confirmation required",
+					ConfirmationToken: token,
+					ExpiresAt:         expires.UTC().Format(time.RFC3339),
+				},
+			)
+		}
+
+		err = common.CheckConfirmToken(subject, req.ConfirmationToken)
+		if err != nil {
+			makeBR(&c, err)
+			return nil
+		}
+
+		err = controller.DeleteNamespace(cluster.Clientset, req.Name)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(models.DeleteNamespaceResponseModel{
+			Status: "namespace deleted",
+		})
+	}
+}
+
+// @Summary        List Resource Quotas
+// @Description    Get resource quotas with current usage against hard limits
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListResourceQuotasRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListResourceQuotasResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listresourcequotas [get]
+func ApiV1ListResourceQuotas(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListResourceQuotasRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		quotas, err := controller.ListResourceQuotas(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(quotas)
+	}
+}
+
+// @Summary        Create Resource Quota
+// @Description    Creates resource quota with the given hard limits
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.ResourceQuotaModel   true   "Request Model of Create Resource Quota"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createresourcequota [post]
+func ApiV1CreateResourceQuota(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ResourceQuotaModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreateResourceQuota(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "resource quota created"})
+	}
+}
+
+// @Summary        Update Resource Quota
+// @Description    Replaces hard limits of the resource quota
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.ResourceQuotaModel   true   "Request Model of Update Resource Quota"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updateresourcequota [post]
+func ApiV1UpdateResourceQuota(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ResourceQuotaModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdateResourceQuota(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "resource quota updated"})
+	}
+}
+
+// @Summary        Delete Resource Quota
+// @Description    Removes the resource quota by given name and namespace
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteResourceQuotaRequestModel   true   "Request Model of Delete Resource Quota"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deleteresourcequota [post]
+func ApiV1DeleteResourceQuota(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteResourceQuotaRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteResourceQuota(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "resource quota deleted"})
+	}
+}
+
+// @Summary        List Limit Ranges
+// @Description    Get limit ranges with their defaults and min/max constraints
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListLimitRangesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListLimitRangesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listlimitranges [get]
+func ApiV1ListLimitRanges(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListLimitRangesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		limitRanges, err := controller.ListLimitRanges(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(limitRanges)
+	}
+}
+
+// @Summary        Create Limit Range
+// @Description    Creates limit range with the given defaults and min/max constraints
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.LimitRangeModel   true   "Request Model of Create Limit Range"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createlimitrange [post]
+func ApiV1CreateLimitRange(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.LimitRangeModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreateLimitRange(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "limit range created"})
+	}
+}
+
+// @Summary        Update Limit Range
+// @Description    Replaces limits of the limit range
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.LimitRangeModel   true   "Request Model of Update Limit Range"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updatelimitrange [post]
+func ApiV1UpdateLimitRange(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.LimitRangeModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdateLimitRange(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "limit range updated"})
+	}
+}
+
+// @Summary        Delete Limit Range
+// @Description    Removes the limit range by given name and namespace
+// @Tags           Namespaces
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteLimitRangeRequestModel   true   "Request Model of Delete Limit Range"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deletelimitrange [post]
+func ApiV1DeleteLimitRange(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteLimitRangeRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteLimitRange(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "limit range deleted"})
+	}
+}
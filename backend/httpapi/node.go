@@ -0,0 +1,272 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Nodes
+// @Description    Get all nodes with capacity, allocatable resources, requests of scheduled pods, taints and conditions
+// @Tags           Nodes
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListNodesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListNodesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listnodes [get]
+func ApiV1ListNodes(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListNodesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		nodes, err := controller.ListNodes(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(nodes)
+	}
+}
+
+// @Summary        Get Node Details
+// @Description    Get details of the node including its addresses and pods scheduled on it
+// @Tags           Nodes
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.GetNodeRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.GetNodeResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/getnode [get]
+func ApiV1GetNode(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetNodeRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		node, err := controller.GetNode(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(node)
+	}
+}
+
+// @Summary        Cordon Node
+// @Description    Marks the node unschedulable, pods already running on it are not affected
+// @Tags           Nodes
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.CordonNodeRequestModel   true   "Request Model of Cordon Node"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/cordonnode [post]
+func ApiV1CordonNode(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.CordonNodeRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.SetNodeUnschedulable(cluster.Clientset, req.Name, true)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "node cordoned"})
+	}
+}
+
+// @Summary        Uncordon Node
+// @Description    Marks the node schedulable again
+// @Tags           Nodes
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.CordonNodeRequestModel   true   "Request Model of Uncordon Node"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/uncordonnode [post]
+func ApiV1UncordonNode(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.CordonNodeRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.SetNodeUnschedulable(cluster.Clientset, req.Name, false)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "node uncordoned"})
+	}
+}
+
+// @Summary        Drain Node
+// @Description    Cordons the node and evicts its pods in background honoring PodDisruptionBudgets. DaemonSet and static pods are skipped, so are pods not managed by a controller unless force is set and pods with emptyDir volumes unless delete_emptydir_data is set; the operation fails when pods were left on the node for these reasons. Returns the operation which progress can be polled with /api/v1/getoperation.
+// @Tags           Nodes
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DrainNodeRequestModel   true   "Request Model of Drain Node"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        202   {object}  models.OperationModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/drainnode [post]
+func ApiV1DrainNode(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DrainNodeRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		op, err := controller.DrainNode(cluster.Clientset, cluster.Name, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.Status(fiber.StatusAccepted).JSON(op)
+	}
+}
+
+// @Summary        Get Operation
+// @Description    Get state and per object progress of the background operation
+// @Tags           Operations
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.GetOperationRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.OperationModel
+// @Failure        400
+// @Failure        401
+// @Failure        404
+// @Router         /api/v1/getoperation [get]
+func ApiV1GetOperation(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetOperationRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		op, err := controller.GetOperation(cluster.Name, req.ID)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(op)
+	}
+}
+
+// @Summary        List Operations
+// @Description    Get running background operations and the ones finished within last hour
+// @Tags           Operations
+// @Security       ApiKeyAuth
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200                {object}    models.ListOperationsResponseModel
+// @Failure        401
+// @Failure        404
+// @Router         /api/v1/listoperations [get]
+func ApiV1ListOperations(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		return c.JSON(controller.ListOperations(cluster.Name))
+	}
+}
@@ -0,0 +1,374 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Pod Disruption Budgets
+// @Description    Get pod disruption budgets with their healthy pods and currently allowed disruptions
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListPDBsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListPDBsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listpdbs [get]
+func ApiV1ListPDBs(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListPDBsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		pdbs, err := controller.ListPDBs(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(pdbs)
+	}
+}
+
+// @Summary        Create Pod Disruption Budget
+// @Description    Creates pod disruption budget for pods with the selector labels, either min_available or max_unavailable is required
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.PDBModel   true   "Request Model of Create Pod Disruption Budget"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createpdb [post]
+func ApiV1CreatePDB(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.PDBModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreatePDB(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "pod disruption budget created"})
+	}
+}
+
+// @Summary        Update Pod Disruption Budget
+// @Description    Replaces selector and budget of the pod disruption budget
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.PDBModel   true   "Request Model of Update Pod Disruption Budget"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updatepdb [post]
+func ApiV1UpdatePDB(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.PDBModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdatePDB(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "pod disruption budget updated"})
+	}
+}
+
+// @Summary        Delete Pod Disruption Budget
+// @Description    Removes the pod disruption budget by given name and namespace
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeletePDBRequestModel   true   "Request Model of Delete Pod Disruption Budget"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deletepdb [post]
+func ApiV1DeletePDB(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeletePDBRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeletePDB(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "pod disruption budget deleted"})
+	}
+}
+
+// @Summary        List Network Policies
+// @Description    Get network policies with their pod selectors, policy types and rules
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListNetworkPoliciesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListNetworkPoliciesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listnetworkpolicies [get]
+func ApiV1ListNetworkPolicies(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListNetworkPoliciesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		policies, err := controller.ListNetworkPolicies(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(policies)
+	}
+}
+
+// @Summary        Create Network Policy
+// @Description    Creates network policy for pods with the selector labels. Peers select pods by pod_selector in the namespace of the policy, by namespace_selector (with optional pod_selector) in other namespaces or IP ranges by cidr
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.NetworkPolicyModel   true   "Request Model of Create Network Policy"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createnetworkpolicy [post]
+func ApiV1CreateNetworkPolicy(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.NetworkPolicyModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreateNetworkPolicy(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "network policy created"})
+	}
+}
+
+// @Summary        Update Network Policy
+// @Description    Replaces pod selector, policy types and rules of the network policy
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.NetworkPolicyModel   true   "Request Model of Update Network Policy"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/updatenetworkpolicy [post]
+func ApiV1UpdateNetworkPolicy(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.NetworkPolicyModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.UpdateNetworkPolicy(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "network policy updated"})
+	}
+}
+
+// @Summary        Delete Network Policy
+// @Description    Removes the network policy by given name and namespace
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteNetworkPolicyRequestModel   true   "Request Model of Delete Network Policy"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deletenetworkpolicy [post]
+func ApiV1DeleteNetworkPolicy(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteNetworkPolicyRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteNetworkPolicy(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "network policy deleted"})
+	}
+}
+
+// @Summary        Evaluate Network Policies
+// @Description    Answer whether the source pod can connect to the port of the destination pod according to the network policies in the namespaces of both pods. Egress policies of the source and ingress policies of the destination have to allow it, pods not selected by any policy of a direction are not isolated. Named ports are resolved from the destination pod, ip blocks are matched against pod IPs. Only the policies are evaluated, not the network plugin
+// @Tags           Policies
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.EvaluateNetworkPolicyRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.EvaluateNetworkPolicyResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/evaluatenetworkpolicy [get]
+func ApiV1EvaluateNetworkPolicy(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.EvaluateNetworkPolicyRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		verdict, err := controller.EvaluateNetworkPolicy(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(verdict)
+	}
+}
@@ -0,0 +1,163 @@
+package httpapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// raw query without the cluster parameter, which is for kubedash,
+// other parameters are kept in their order
+func proxyQuery(rawQuery string) string {
+
+	params := []string{}
+	for _, param := range strings.Split(rawQuery, "&") {
+		key, _, _ := strings.Cut(param, "=")
+		if key, err := url.QueryUnescape(key); param == "" || (err == nil && key == "cluster") {
+			continue
+		}
+		params = append(params, param)
+	}
+
+	return strings.Join(params, "&")
+}
+
+// pipe the hijacked client connection and the upgraded connection
+// to the backend until one of them is closed
+func pipeUpgraded(conn net.Conn, backend io.ReadWriteCloser) {
+
+	done := make(chan struct{})
+	go func() {
+		io.Copy(backend, conn)
+		close(done)
+	}()
+	io.Copy(conn, backend)
+
+	// unblock the other direction
+	backend.Close()
+	conn.Close()
+	<-done
+}
+
+// @Summary        Proxy To Pod Or Service
+// @Description    Forward the request to a port of the pod or service through the proxy subresource of the API server. The path after the port, query (without the cluster parameter), headers and streamed body are forwarded, Authorization, Cookie, Impersonate-* and hop-by-hop headers are not. WebSocket (and other) upgrades are passed through. When impersonating, the user needs the verb of the method (get, create, update, patch, delete) on pods/proxy or services/proxy. Without impersonation the proxy is disabled unless the server runs with -allow-proxy, every logged in user can then reach every pod and service. Every request is written to the audit log.
+// @Tags           Proxy
+// @Security       ApiKeyAuth
+// @Param          kind        path    string   true    "Kind of the target"   Enums(pods, services)
+// @Param          namespace   path    string   true    "Namespace of the pod or service"
+// @Param          name        path    string   true    "Name of the pod or service"
+// @Param          port        path    string   true    "Port number or name of the container or service port"
+// @Param          path        path    string   true    "Path on the target port"
+// @Param          cluster     query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200
+// @Success        101
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/proxy/{kind}/{namespace}/{name}/{port}/{path} [get]
+// @Router         /api/v1/proxy/{kind}/{namespace}/{name}/{port}/{path} [post]
+// @Router         /api/v1/proxy/{kind}/{namespace}/{name}/{port}/{path} [put]
+// @Router         /api/v1/proxy/{kind}/{namespace}/{name}/{port}/{path} [patch]
+// @Router         /api/v1/proxy/{kind}/{namespace}/{name}/{port}/{path} [delete]
+func ApiV1Proxy(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ProxyRequestModel)
+		err = c.Bind().URI(req)
+		if err != nil {
+			makeBR(&c, err)
+			return nil
+		}
+		req.Path = c.Params("*")
+		req.Method = c.Method()
+		req.Query = proxyQuery(string(c.Request().URI().QueryString()))
+
+		header := http.Header{}
+		for name, values := range c.GetReqHeaders() {
+			for _, value := range values {
+				header.Add(name, value)
+			}
+		}
+
+		var body io.Reader
+		if stream := c.Request().BodyStream(); stream != nil {
+			body = stream
+		} else if len(c.Body()) > 0 {
+			body = bytes.NewReader(c.Body())
+		}
+
+		user := loggedInUser(&c)
+		if user == "" {
+			user = "anonymous"
+		}
+		audit := func(status string) {
+			log.Printf(
+				"audit: user %s proxy %s %s/%s/%s:%s/%s in cluster %s: %s",
+				user, req.Method, req.Kind, req.Namespace, req.Name, req.Port, req.Path,
+				cluster.Name, status,
+			)
+		}
+
+		// without impersonation there's no RBAC of the user to check
+		if !clusters.Impersonate && !clusters.AllowProxy {
+			err = fmt.Errorf(
+				"%w: proxy needs impersonation or -allow-proxy", controller.ErrForbidden,
+			)
+			audit(err.Error())
+			makeError(&c, err)
+			return nil
+		}
+
+		resp, err := controller.Proxy(cluster, req, header, body)
+		if err != nil {
+			audit(err.Error())
+			makeError(&c, err)
+			return nil
+		}
+		audit(resp.Status)
+
+		c.Status(resp.StatusCode)
+		for name, values := range resp.Header {
+			for _, value := range values {
+				c.Response().Header.Add(name, value)
+			}
+		}
+
+		if resp.StatusCode == http.StatusSwitchingProtocols {
+			backend, ok := resp.Body.(io.ReadWriteCloser)
+			if !ok {
+				resp.Body.Close()
+				c.Response().Header.Reset()
+				makeISE(&c, errors.New("upgraded connection is not writable"))
+				return nil
+			}
+			// the response is written first, then the connection is ours
+			c.Context().Hijack(func(conn net.Conn) {
+				pipeUpgraded(conn, backend)
+			})
+			return nil
+		}
+
+		// the body is closed by fasthttp once it's sent (or the client is gone)
+		return c.SendStream(resp.Body, int(resp.ContentLength))
+	}
+}
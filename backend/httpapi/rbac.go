@@ -0,0 +1,329 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Roles
+// @Description    Get roles with their rules
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListRolesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListRolesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listroles [get]
+func ApiV1ListRoles(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListRolesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		roles, err := controller.ListRoles(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(roles)
+	}
+}
+
+// @Summary        List Cluster Roles
+// @Description    Get cluster roles with their rules, rules aggregated from other cluster roles are included
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListClusterRolesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListRolesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listclusterroles [get]
+func ApiV1ListClusterRoles(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListClusterRolesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		roles, err := controller.ListClusterRoles(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(roles)
+	}
+}
+
+// @Summary        List Role Bindings
+// @Description    Get role bindings with their role and subjects
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListRoleBindingsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListRoleBindingsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listrolebindings [get]
+func ApiV1ListRoleBindings(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListRoleBindingsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		bindings, err := controller.ListRoleBindings(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(bindings)
+	}
+}
+
+// @Summary        List Cluster Role Bindings
+// @Description    Get cluster role bindings with their role and subjects
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListClusterRoleBindingsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListRoleBindingsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listclusterrolebindings [get]
+func ApiV1ListClusterRoleBindings(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListClusterRoleBindingsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		bindings, err := controller.ListClusterRoleBindings(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(bindings)
+	}
+}
+
+// @Summary        List Service Accounts
+// @Description    Get service accounts with their secrets
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListServiceAccountsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListServiceAccountsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listserviceaccounts [get]
+func ApiV1ListServiceAccounts(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListServiceAccountsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		accounts, err := controller.ListServiceAccounts(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(accounts)
+	}
+}
+
+// @Summary        Who Can
+// @Description    Get subjects the roles and bindings of the cluster allow the request, with the bindings granting it. Subjects can be groups, other authorizers than RBAC are not considered
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.WhoCanRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.WhoCanResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/whocan [get]
+func ApiV1WhoCan(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.WhoCanRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		subjects, err := controller.WhoCan(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(subjects)
+	}
+}
+
+// @Summary        Subject Permissions
+// @Description    Get rules the roles and bindings of the cluster grant to the user, group or service account, directly or through its groups
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.SubjectPermissionsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.SubjectPermissionsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/subjectpermissions [get]
+func ApiV1SubjectPermissions(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.SubjectPermissionsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		permissions, err := controller.SubjectPermissions(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(permissions)
+	}
+}
+
+// @Summary        Review Access
+// @Description    Asks the API server whether the request is allowed (SelfSubjectAccessReview), for the logged in user when impersonating and for the server's own credentials otherwise
+// @Tags           RBAC
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Produce        json
+// @Param          request   body   models.AccessReviewRequestModel   true   "Request Model of Review Access"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.AccessReviewResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/reviewaccess [post]
+func ApiV1ReviewAccess(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.AccessReviewRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		review, err := controller.ReviewAccess(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(review)
+	}
+}
@@ -8,13 +8,11 @@ import (
 
 	"github.com/gofiber/fiber/v3"
 	"gorm.io/gorm"
-
-	"k8s.io/client-go/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/kube-dash/kube-dash-backend/controller"
 	"github.com/kube-dash/kube-dash-backend/database"
 	"github.com/kube-dash/kube-dash-backend/models"
-	metricsv "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 // function that checks if a given model has required fields
@@ -68,6 +66,31 @@ func parseValidateParams(c *fiber.Ctx, req interface{}, isBody bool) error {
 
 }
 
+// parse RFC3339 start and end of the metrics time range, both are nil when
+// neither is set and both are required otherwise
+func parseTimeRange(c *fiber.Ctx, start string, end string) (*time.Time, *time.Time, error) {
+
+	if start == "" && end == "" {
+		return nil, nil, nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		err = errors.New("unable to parse start_time")
+		makeBR(c, err)
+		return nil, nil, err
+	}
+
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		err = errors.New("unable to parse end_time")
+		makeBR(c, err)
+		return nil, nil, err
+	}
+
+	return &startTime, &endTime, nil
+}
+
 // make internal server error
 func makeISE(c *fiber.Ctx, err error) {
 	(*c).Status(fiber.StatusInternalServerError).JSON(
@@ -82,31 +105,74 @@ func makeBR(c *fiber.Ctx, err error) {
 	)
 }
 
+// make service unavailable error
+func makeSU(c *fiber.Ctx, err error) {
+	(*c).Status(fiber.StatusServiceUnavailable).JSON(
+		fiber.Map{"error": err.Error()},
+	)
+}
+
+// make forbidden error
+func makeFB(c *fiber.Ctx, err error) {
+	(*c).Status(fiber.StatusForbidden).JSON(
+		fiber.Map{"error": err.Error()},
+	)
+}
+
+// make bad request error when the controller rejected the request itself,
+// forbidden when the impersonated user isn't allowed to do it,
+// service unavailable when the cache is not ready yet,
+// otherwise make internal server error
+func makeError(c *fiber.Ctx, err error) {
+	if errors.Is(err, controller.ErrInvalidRequest) {
+		makeBR(c, err)
+		return
+	}
+	if errors.Is(err, controller.ErrForbidden) || apierrors.IsForbidden(err) {
+		makeFB(c, err)
+		return
+	}
+	if errors.Is(err, controller.ErrCacheNotSynced) {
+		makeSU(c, err)
+		return
+	}
+	makeISE(c, err)
+}
+
 // @Summary        List Available Pods (deprecated)
-// @Description    Get all available pods in the cluster
+// @Description    Get all available pods in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.
 // @Deprecated     true
 // @Tags           Pods
 // @Security       ApiKeyAuth
 // @Param          request   query   models.ListPodsV1RequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/listpods [get]
-func ApiV1ListPods(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1ListPods(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.ListPodsV1RequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		pods, err := controller.ListPodsV1(clientset, req.Namespace)
+		pods, err := controller.ListPodsV1(cluster.Clientset, cluster.Cache, req)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -115,29 +181,38 @@ func ApiV1ListPods(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        List Available Pods
-// @Description    Get all available pods in the cluster
+// @Description    Get all available pods in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.
 // @Tags           Pods
 // @Security       ApiKeyAuth
 // @Param          request   query   models.ListPodsV2RequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200                {object}    models.ListPodsV2ResponseModel
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v2/listpods [get]
-func ApiV2ListPods(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV2ListPods(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.ListPodsV2RequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		resp, err := controller.ListPodsV2(clientset, req)
+		resp, err := controller.ListPodsV2(cluster.Clientset, cluster.Cache, req)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -146,29 +221,38 @@ func ApiV2ListPods(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        List Available Containers
-// @Description    Get all available containers in the cluster
+// @Description    Get all available containers in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.
 // @Tags           Containers
 // @Security       ApiKeyAuth
 // @Param          request   query   models.ListContainersRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200                {object}    models.ListContainersReponseModel
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/listcontainers [get]
-func ApiV1ListContainers(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1ListContainers(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.ListContainersRequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		resp, err := controller.ListContainers(clientset, req)
+		resp, err := controller.ListContainers(cluster.Clientset, cluster.Cache, req)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -180,19 +264,28 @@ func ApiV1ListContainers(clientset *kubernetes.Clientset) fiber.Handler {
 // @Description    Returns the list of all available namespaces in the cluster
 // @Tags           Namespaces
 // @Security       ApiKeyAuth
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200                {array}    string
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/listnamespaces [get]
-func ApiV1ListNamespaces(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1ListNamespaces(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
-		namespaces, err := controller.ListNamespaces(clientset)
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		namespaces, err := controller.ListNamespaces(cluster.Clientset)
 
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -202,29 +295,38 @@ func ApiV1ListNamespaces(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        List All Deployments
-// @Description    Get all deployments in the cluster
+// @Description    Get all deployments in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.
 // @Tags           Deployment
 // @Security       ApiKeyAuth
 // @Param          request   query   models.ListDeploymentsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200                {object}    models.ListDeploymentsResponseModel
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/listdeployments [get]
-func ApiV1ListDeployments(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1ListDeployments(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.ListDeploymentsRequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		pods, err := controller.ListDeployments(clientset, req)
+		pods, err := controller.ListDeployments(cluster.Clientset, cluster.Cache, req)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -233,31 +335,49 @@ func ApiV1ListDeployments(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        Create New Deployment
-// @Description    Create a new deployment in the cluster with the given name, namespace and parameters.
+// @Description    Create a new deployment in the cluster with the given name, namespace and parameters. With diff set nothing is created, the server-side dry run result is returned as models.DiffModel.
 // @Tags           Deployment
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   body   models.CreateDeploymentRequestModel   true   "Request Model of Create Deployment"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/createdeployment [post]
-func ApiV1CreateDeployment(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1CreateDeployment(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.CreateDeploymentRequestModel)
-		err := parseValidateParams(&c, req, true)
+		err = parseValidateParams(&c, req, true)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		_, err = controller.CreateDeployment(clientset, req.Namespace, req)
+		if req.Diff {
+			diff, err := controller.DiffCreateDeployment(cluster.Clientset, req.Namespace, req)
+			if err != nil {
+				makeError(&c, err)
+				return nil
+			}
+			return c.JSON(diff)
+		}
+
+		_, err = controller.CreateDeployment(cluster.Clientset, req.Namespace, req)
 
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -267,65 +387,104 @@ func ApiV1CreateDeployment(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        Update Existing Deployment
-// @Description    Update the parameters of already existing deployment.
+// @Description    Update the parameters of already existing deployment. Setting replicas of a deployment scaled by a horizontal pod autoscaler returns warnings, the autoscaler overrides the count. With diff set nothing is updated, the server-side dry run result is returned as models.DiffModel.
 // @Tags           Deployment
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   body   models.UpdateDeploymentRequestModel   true   "Request Model of Update Deployment"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
-// @Success        200
+// @Success        200                {object}    models.UpdateDeploymentResponseModel
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/updatedeployment [post]
-func ApiV1UpdateDeployment(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1UpdateDeployment(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.UpdateDeploymentRequestModel)
-		err := parseValidateParams(&c, req, true)
+		err = parseValidateParams(&c, req, true)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		err = controller.UpdateDeployment(clientset, req)
+		if req.Diff {
+			diff, err := controller.DiffUpdateDeployment(cluster.Clientset, req)
+			if err != nil {
+				makeError(&c, err)
+				return nil
+			}
+			return c.JSON(diff)
+		}
+
+		warnings, err := controller.UpdateDeployment(cluster.Clientset, req)
 
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
 		// Return a success response
-		return c.JSON(fiber.Map{"status": "deployment updated"})
+		return c.JSON(models.UpdateDeploymentResponseModel{
+			Status:   "deployment updated",
+			Warnings: warnings,
+		})
 	}
 }
 
 // @Summary        Delete Deployment
-// @Description    Removes the deployment by given name and namespace.
+// @Description    Removes the deployment by given name and namespace. With diff set nothing is removed, the server-side dry run result is returned as models.DiffModel.
 // @Tags           Deployment
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   body   models.DeleteDeploymentRequestModel   true   "Request Model of Delete Deployment"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/deletedeployment [post]
-func ApiV1DeleteDeployment(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1DeleteDeployment(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.DeleteDeploymentRequestModel)
-		err := parseValidateParams(&c, req, true)
+		err = parseValidateParams(&c, req, true)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		err = controller.DeleteDeployment(clientset, req)
+		if req.Diff {
+			diff, err := controller.DiffDeleteDeployment(cluster.Clientset, req)
+			if err != nil {
+				makeError(&c, err)
+				return nil
+			}
+			return c.JSON(diff)
+		}
+
+		err = controller.DeleteDeployment(cluster.Clientset, req)
 
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -341,26 +500,35 @@ func ApiV1DeleteDeployment(clientset *kubernetes.Clientset) fiber.Handler {
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   query   models.GetPodMetricsV1RequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/getpodmetrics [get]
-func ApiV1GetPodMetrics(metricsset *metricsv.Clientset) fiber.Handler {
+func ApiV1GetPodMetrics(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		// Parse the user-submitted data from the request body
 		req := new(models.GetPodMetricsV1RequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		metrics, err := controller.GetPodMetricsV1(metricsset, req.Namespace)
+		metrics, err := controller.GetPodMetricsV1(cluster.Metricsset, req.Namespace)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -369,22 +537,31 @@ func ApiV1GetPodMetrics(metricsset *metricsv.Clientset) fiber.Handler {
 }
 
 // @Summary        Get Pod Metrics
-// @Description    Get metrics for specific pod or all pods in the cluster
+// @Description    Get metrics for specific pod or all pods in the cluster. The user needs the list verb on pods.metrics.k8s.io in the namespace (cluster wide without namespace)
 // @Tags           Metrics
 // @Security       ApiKeyAuth
 // @Param          request   query   models.GetPodMetricsV2RequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200                {object}    models.DBClusterMetricsModel
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v2/getpodmetrics [get]
-func ApiV2GetPodMetrics(metricsset *metricsv.Clientset, db *gorm.DB) fiber.Handler {
+func ApiV2GetPodMetrics(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		// Parse the user-submitted data from the request body
 		req := new(models.GetPodMetricsV2RequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
@@ -397,39 +574,120 @@ func ApiV2GetPodMetrics(metricsset *metricsv.Clientset, db *gorm.DB) fiber.Handl
 		}
 
 		// if they're not set, GetPodMetricsV2 will return the most recent record
-		var startTime *time.Time = nil
-		var endTime *time.Time = nil
+		startTime, endTime, err := parseTimeRange(&c, req.StartTime, req.EndTime)
+		if err != nil {
+			// will return status bad request set in parseTimeRange
+			return nil
+		}
 
-		// if either start_time or end_time is set, we should validate them
-		if req.StartTime != "" || req.EndTime != "" {
+		metrics, err := controller.GetPodMetricsV2(
+			cluster, db, podname, req, startTime, endTime,
+		)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
 
-			// parse time
-			parsedStartTime, err := time.Parse(time.RFC3339, req.StartTime)
-			if err != nil {
-				makeBR(&c, errors.New("unable to parse start_time"))
-				return nil
-			}
+		// WARN: breaking change {"metrics": metrics} -> metrics
+		return c.JSON(metrics)
+	}
+}
 
-			parsedEndTime, err := time.Parse(time.RFC3339, req.EndTime)
-			if err != nil {
-				makeBR(&c, errors.New("unable to parse end_time"))
-				return nil
-			}
+// @Summary        Get Node Metrics
+// @Description    Get CPU and memory usage with allocatable resources of specific node or all nodes in the cluster, with the same time range rules as /api/v2/getpodmetrics. The user needs the list verb on nodes.metrics.k8s.io
+// @Tags           Metrics
+// @Security       ApiKeyAuth
+// @Param          request   query   models.GetNodeMetricsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200                {object}    models.DBClusterNodeMetricsModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/getnodemetrics [get]
+func ApiV1GetNodeMetrics(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
 
-			startTime = &parsedStartTime
-			endTime = &parsedEndTime
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
 
+		req := new(models.GetNodeMetricsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
 		}
 
-		metrics, err := controller.GetPodMetricsV2(
-			metricsset, db, podname, startTime, endTime,
-		)
+		// set default value for nodename, if not specified will select all nodes
+		nodename := "%"
+		if req.NodeName != "" {
+			nodename = req.NodeName
+		}
+
+		// if they're not set, GetNodeMetrics will return the most recent record
+		startTime, endTime, err := parseTimeRange(&c, req.StartTime, req.EndTime)
 		if err != nil {
-			makeISE(&c, err)
+			// will return status bad request set in parseTimeRange
+			return nil
+		}
+
+		metrics, err := controller.GetNodeMetrics(cluster, db, nodename, startTime, endTime)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(metrics)
+	}
+}
+
+// @Summary        Get Workload Metrics
+// @Description    Get CPU and memory usage of the pods of workloads or namespaces aggregated per interval from the collected pod metrics. Pods are assigned to workloads by their owner references, the time range is limited to 72 hours and 1000 intervals. The user needs the list verb on pods.metrics.k8s.io in the namespace (cluster wide without namespace)
+// @Tags           Metrics
+// @Security       ApiKeyAuth
+// @Param          request   query   models.GetWorkloadMetricsRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200                {object}    models.GetWorkloadMetricsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/getworkloadmetrics [get]
+func ApiV1GetWorkloadMetrics(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetWorkloadMetricsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		startTime, endTime, err := parseTimeRange(&c, req.StartTime, req.EndTime)
+		if err != nil {
+			// will return status bad request set in parseTimeRange
+			return nil
+		}
+
+		metrics, err := controller.GetWorkloadMetrics(cluster, db, req, *startTime, *endTime)
+		if err != nil {
+			makeError(&c, err)
 			return nil
 		}
 
-		// WARN: breaking change {"metrics": metrics} -> metrics
 		return c.JSON(metrics)
 	}
 }
@@ -440,18 +698,26 @@ func ApiV2GetPodMetrics(metricsset *metricsv.Clientset, db *gorm.DB) fiber.Handl
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   body   models.DeletePodMetricsRequestModel   false   "Request Model of Deleting Pod Metrics"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200   {object}  object  "Success"
 // @Failure        400
 // @Failure        401
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/deletepodmetrics [post]
-func ApiV1DeletePodMetrics(db *gorm.DB) fiber.Handler {
+func ApiV1DeletePodMetrics(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		// Parse the user-submitted data from the request body
 		req := new(models.DeletePodMetricsRequestModel)
-		err := parseValidateParams(&c, req, true)
+		err = parseValidateParams(&c, req, true)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
@@ -482,7 +748,7 @@ func ApiV1DeletePodMetrics(db *gorm.DB) fiber.Handler {
 
 		}
 
-		err = database.DBDeletePodMetrics(db, startTime, endTime)
+		err = database.DBDeletePodMetrics(db, cluster.Name, startTime, endTime)
 		if err != nil {
 			makeISE(&c, err)
 			return nil
@@ -495,30 +761,48 @@ func ApiV1DeletePodMetrics(db *gorm.DB) fiber.Handler {
 }
 
 // @Summary        Create Service
-// @Description    Creates service in kubernetes cluster. Currently only NodePort and ClusterIP are supported, LoadBalancer coming later.
+// @Description    Creates service in kubernetes cluster. Currently only NodePort and ClusterIP are supported, LoadBalancer coming later. With diff set nothing is created, the server-side dry run result is returned as models.DiffModel.
 // @Tags           Services
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   body   models.CreateServiceRequestModel   false   "Request Model of Create Service"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200   {object}  object  "Success"
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/createservice [post]
-func ApiV1CreateService(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1CreateService(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.CreateServiceRequestModel)
-		err := parseValidateParams(&c, req, true)
+		err = parseValidateParams(&c, req, true)
 		if err != nil {
 			return nil
 		}
 
-		err = controller.CreateService(clientset, req)
+		if req.Diff {
+			diff, err := controller.DiffCreateService(cluster.Clientset, req)
+			if err != nil {
+				makeError(&c, err)
+				return nil
+			}
+			return c.JSON(diff)
+		}
+
+		err = controller.CreateService(cluster.Clientset, req)
 
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -528,29 +812,38 @@ func ApiV1CreateService(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        List Available Services
-// @Description    Get all available services in the cluster
+// @Description    Get all available services in the cluster. Served from the resource cache, use fresh=true to read directly from the API server. Requests with field_selector, limit or continue are always served by the API server.
 // @Tags           Services
 // @Security       ApiKeyAuth
 // @Produce        json
 // @Param          request   query   models.ListServicesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Success        200                {object}    models.ListServicesResponseModel
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/listservices [get]
-func ApiV1ListServices(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1ListServices(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.ListServicesRequestModel)
-		err := parseValidateParams(&c, req, false)
+		err = parseValidateParams(&c, req, false)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		services, err := controller.ListServices(clientset, req)
+		services, err := controller.ListServices(cluster.Clientset, cluster.Cache, req)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -559,30 +852,48 @@ func ApiV1ListServices(clientset *kubernetes.Clientset) fiber.Handler {
 }
 
 // @Summary        Delete Service
-// @Description    Removes the service by given name and namespace
+// @Description    Removes the service by given name and namespace. With diff set nothing is removed, the server-side dry run result is returned as models.DiffModel.
 // @Tags           Services
 // @Security       ApiKeyAuth
 // @Accept         json
 // @Param          request   body   models.DeleteServiceRequestModel   false   "Request Model of Delete Service"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
 // @Produce        json
 // @Success        200   {object}  object  "Success"
 // @Failure        400
 // @Failure        401
+// @Failure        403
+// @Failure        404
 // @Failure        500
 // @Router         /api/v1/deleteservice [post]
-func ApiV1DeleteService(clientset *kubernetes.Clientset) fiber.Handler {
+func ApiV1DeleteService(clusters *controller.ClusterRegistry) fiber.Handler {
 	return func(c fiber.Ctx) error {
 
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
 		req := new(models.DeleteServiceRequestModel)
-		err := parseValidateParams(&c, req, true)
+		err = parseValidateParams(&c, req, true)
 		if err != nil {
 			// will return status bad request set in parseValidateBody
 			return nil
 		}
 
-		err = controller.DeleteService(clientset, req)
+		if req.Diff {
+			diff, err := controller.DiffDeleteService(cluster.Clientset, req)
+			if err != nil {
+				makeError(&c, err)
+				return nil
+			}
+			return c.JSON(diff)
+		}
+
+		err = controller.DeleteService(cluster.Clientset, req)
 		if err != nil {
-			makeISE(&c, err)
+			makeError(&c, err)
 			return nil
 		}
 
@@ -0,0 +1,1018 @@
+package httpapi
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	authorizationapiv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaapiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+func TestPublicRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	resp := s.do(t, http.MethodGet, "/api/v1/accessible", nil, nil, "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected accessible without token, got %d", resp.StatusCode)
+	}
+
+	resp = s.do(t, http.MethodGet, "/api/v1/ready", nil, nil, "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected synced cache to be ready, got %d", resp.StatusCode)
+	}
+
+	resp = s.do(t, http.MethodGet, "/api/v1/ready", url.Values{"cluster": {"missing"}}, nil, "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected unknown cluster to be not found, got %d", resp.StatusCode)
+	}
+
+	s.expect(t, http.StatusUnauthorized, http.MethodPost, "/api/v1/login", nil, map[string]string{
+		"user": "john", "pass": "wrong",
+	})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/login", nil, nil)
+}
+
+func TestRestrictedRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	resp := s.do(t, http.MethodGet, "/api/v1/restricted", nil, nil, "")
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized without token, got %d", resp.StatusCode)
+	}
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/restricted", nil, nil)
+	if body["username"] != "john" {
+		t.Fatalf("expected token of john, got %v", body)
+	}
+
+	// every cluster aware route rejects unknown clusters
+	s.expect(t, http.StatusNotFound, http.MethodGet, "/api/v2/listpods",
+		url.Values{"cluster": {"missing"}}, nil)
+}
+
+func TestClusterRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listclusters", nil, nil)
+	if listLen(t, body, "clusters") != 1 {
+		t.Fatalf("expected one cluster, got %v", body)
+	}
+	cluster := body["clusters"].([]interface{})[0].(map[string]interface{})
+	if cluster["name"] != testClusterName || cluster["default"] != true || cluster["reachable"] != true {
+		t.Fatalf("unexpected cluster %v", cluster)
+	}
+}
+
+func TestPodRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listpods", nil, nil)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/listpods",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "pods") != 1 {
+		t.Fatalf("expected one pod, got %v", body)
+	}
+
+	// fresh reads go to the API server
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/listpods",
+		url.Values{"fresh": {"true"}, "label_selector": {"app=other"}}, nil)
+	if listLen(t, body, "pods") != 0 {
+		t.Fatalf("expected no pods matching the selector, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/listpods",
+		url.Values{"sort_by": {"restarts"}, "order": {"desc"}}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v2/listpods",
+		url.Values{"label_selector": {"app in ("}}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v2/listpods",
+		url.Values{"sort_by": {"size"}}, nil)
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listcontainers",
+		url.Values{"namespace": {"default"}, "pod_name": {"nginx-5d8-abcde"}}, nil)
+}
+
+func TestNamespaceRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listnamespaces", nil, nil)
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/listnamespaces", nil, nil)
+	if listLen(t, body, "namespaces") != 2 {
+		t.Fatalf("expected two namespaces, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createnamespace", nil,
+		models.CreateNamespaceRequestModel{
+			Name: "staging", Labels: map[string]string{"team": "qa"},
+			Annotations: map[string]string{"owner": "john"},
+		})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/createnamespace", nil,
+		models.CreateNamespaceRequestModel{})
+
+	// annotations left out of the update are kept
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatenamespace", nil,
+		models.UpdateNamespaceRequestModel{Name: "staging", Labels: map[string]string{"team": "payments"}})
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/listnamespaces", nil, nil)
+	var staging map[string]interface{}
+	for _, namespace := range body["namespaces"].([]interface{}) {
+		if namespace.(map[string]interface{})["name"] == "staging" {
+			staging = namespace.(map[string]interface{})
+		}
+	}
+	labels, _ := staging["labels"].(map[string]interface{})
+	annotations, _ := staging["annotations"].(map[string]interface{})
+	if labels["team"] != "payments" || annotations["owner"] != "john" {
+		t.Fatalf("expected updated labels and kept annotations, got %v", staging)
+	}
+
+	// deletion has to be confirmed with the token from the first request
+	body = s.expect(t, http.StatusAccepted, http.MethodPost, "/api/v1/deletenamespace", nil,
+		models.DeleteNamespaceRequestModel{Name: "staging"})
+	token, _ := body["confirmation_token"].(string)
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/deletenamespace", nil,
+		models.DeleteNamespaceRequestModel{Name: "payments", ConfirmationToken: token})
+	resp := s.do(t, http.MethodPost, "/api/v1/deletenamespace", nil,
+		models.DeleteNamespaceRequestModel{Name: "staging", ConfirmationToken: token}, tokenOf(t, "jane"))
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected token of another user to be rejected, got %d", resp.StatusCode)
+	}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletenamespace", nil,
+		models.DeleteNamespaceRequestModel{Name: "staging", ConfirmationToken: token})
+}
+
+func TestResourceQuotaRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listresourcequotas",
+		url.Values{"namespace": {"payments"}}, nil)
+	if listLen(t, body, "resource_quotas") != 1 {
+		t.Fatalf("expected one quota, got %v", body)
+	}
+
+	quota := models.ResourceQuotaModel{
+		Namespace: "payments", Name: "storage", Hard: map[string]string{"requests.storage": "100Gi"},
+	}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createresourcequota", nil, quota)
+	quota.Hard["persistentvolumeclaims"] = "10"
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updateresourcequota", nil, quota)
+	quota.Hard["pods"] = "many"
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/updateresourcequota", nil, quota)
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deleteresourcequota", nil,
+		models.DeleteResourceQuotaRequestModel{Namespace: "payments", Name: "storage"})
+}
+
+func TestLimitRangeRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listlimitranges",
+		url.Values{"namespace": {"payments"}}, nil)
+	if listLen(t, body, "limit_ranges") != 1 {
+		t.Fatalf("expected one limit range, got %v", body)
+	}
+
+	limitRange := models.LimitRangeModel{
+		Namespace: "payments", Name: "pods",
+		Limits: []models.LimitRangeItemModel{{Type: "Pod", Max: map[string]string{"cpu": "2"}}},
+	}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createlimitrange", nil, limitRange)
+	limitRange.Limits[0].Max["memory"] = "4Gi"
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatelimitrange", nil, limitRange)
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletelimitrange", nil,
+		models.DeleteLimitRangeRequestModel{Namespace: "payments", Name: "pods"})
+}
+
+func TestNodeRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listnodes",
+		url.Values{"sort_by": {"name"}}, nil)
+	if listLen(t, body, "nodes") != 2 {
+		t.Fatalf("expected two nodes, got %v", body)
+	}
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getnode",
+		url.Values{"name": {"worker-1"}}, nil)
+	if body["name"] != "worker-1" {
+		t.Fatalf("unexpected node %v", body)
+	}
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getnode", nil, nil)
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/cordonnode", nil,
+		models.CordonNodeRequestModel{Name: "worker-2"})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/uncordonnode", nil,
+		models.CordonNodeRequestModel{Name: "worker-2"})
+}
+
+func TestOperationRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	// fake evictions don't remove pods, the drain runs until the timeout
+	body := s.expect(t, http.StatusAccepted, http.MethodPost, "/api/v1/drainnode", nil,
+		models.DrainNodeRequestModel{Name: "worker-1", TimeoutSeconds: 1})
+	id, _ := body["id"].(string)
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getoperation",
+		url.Values{"id": {id}}, nil)
+	if body["kind"] != "drain" || body["target"] != "node/worker-1" || body["cluster"] != testClusterName {
+		t.Fatalf("unexpected operation %v", body)
+	}
+
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getoperation",
+		url.Values{"id": {"missing"}}, nil)
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listoperations", nil, nil)
+	if listLen(t, body, "operations") == 0 {
+		t.Fatalf("expected the drain operation, got %v", body)
+	}
+}
+
+func TestDeploymentRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listdeployments",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "deployments") != 1 {
+		t.Fatalf("expected one deployment, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createdeployment", nil,
+		models.CreateDeploymentRequestModel{
+			Namespace: "default", Name: "api", Image: "api:1.0", Replicas: 1,
+			CPURequest: "100m", MemoryRequest: "128Mi",
+		})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/createdeployment", nil,
+		models.CreateDeploymentRequestModel{Namespace: "default", Name: "web", Image: "web:1.0"})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatedeployment", nil,
+		models.UpdateDeploymentRequestModel{Namespace: "default", Name: "api", Replicas: 3})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletedeployment", nil,
+		models.DeleteDeploymentRequestModel{Namespace: "default", Name: "api"})
+}
+
+func TestHPARoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listhpas",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "hpas") != 1 {
+		t.Fatalf("expected one hpa, got %v", body)
+	}
+	hpa := body["hpas"].([]interface{})[0].(map[string]interface{})
+	current := hpa["current"].([]interface{})
+	if hpa["desired_replicas"] != float64(3) || len(current) != 1 ||
+		current[0].(map[string]interface{})["utilization"] != float64(95) {
+		t.Fatalf("expected desired replicas and current utilization, got %v", hpa)
+	}
+
+	body = s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatedeployment", nil,
+		models.UpdateDeploymentRequestModel{Namespace: "default", Name: "nginx", Replicas: 4})
+	if listLen(t, body, "warnings") != 1 {
+		t.Fatalf("expected warning about the autoscaler, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createhpa", nil, models.HPAModel{
+		Namespace: "default", Name: "db", TargetKind: "StatefulSet", TargetName: "db",
+		MaxReplicas: 3, MemoryUtilization: 80,
+	})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/createhpa", nil, models.HPAModel{
+		Namespace: "default", Name: "web", TargetKind: "Deployment", TargetName: "web",
+		MinReplicas: 5, MaxReplicas: 3, CPUUtilization: 50,
+	})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatehpa", nil, models.HPAModel{
+		Namespace: "default", Name: "db", TargetKind: "StatefulSet", TargetName: "db",
+		MinReplicas: 2, MaxReplicas: 5, CPUUtilization: 60, MemoryUtilization: 80,
+	})
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listhpas",
+		url.Values{"namespace": {"default"}, "sort_by": {"name"}}, nil)
+	db := body["hpas"].([]interface{})[0].(map[string]interface{})
+	if db["name"] != "db" || db["max_replicas"] != float64(5) || len(db["targets"].([]interface{})) != 2 {
+		t.Fatalf("expected updated db hpa, got %v", db)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletehpa", nil,
+		models.DeleteHPARequestModel{Namespace: "default", Name: "db"})
+}
+
+func TestPolicyRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createpdb", nil, models.PDBModel{
+		Namespace: "default", Name: "nginx", Selector: map[string]string{"app": "nginx"},
+		MinAvailable: "1",
+	})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/createpdb", nil, models.PDBModel{
+		Namespace: "default", Name: "web", Selector: map[string]string{"app": "web"},
+		MinAvailable: "1", MaxUnavailable: "50%",
+	})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatepdb", nil, models.PDBModel{
+		Namespace: "default", Name: "nginx", Selector: map[string]string{"app": "nginx"},
+		MaxUnavailable: "50%",
+	})
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listpdbs",
+		url.Values{"namespace": {"default"}}, nil)
+	pdb := body["pdbs"].([]interface{})[0].(map[string]interface{})
+	if pdb["selector"] != "app=nginx" || pdb["max_unavailable"] != "50%" {
+		t.Fatalf("expected updated pdb, got %v", pdb)
+	}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletepdb", nil,
+		models.DeletePDBRequestModel{Namespace: "default", Name: "nginx"})
+
+	policy := models.NetworkPolicyModel{
+		Namespace: "default", Name: "nginx-ingress", PodSelector: map[string]string{"app": "nginx"},
+		PolicyTypes: []string{"Ingress"},
+		Ingress: []models.NetworkPolicyRuleModel{{
+			Peers: []models.NetworkPolicyPeerModel{{PodSelector: map[string]string{"app": "web"}}},
+			Ports: []models.NetworkPolicyPortModel{{Port: "80"}},
+		}},
+	}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createnetworkpolicy", nil, policy)
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listnetworkpolicies",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "network_policies") != 1 {
+		t.Fatalf("expected one network policy, got %v", body)
+	}
+
+	evaluate := url.Values{
+		"source_namespace": {"default"}, "source_pod": {"nginx-5d8-abcde"},
+		"destination_namespace": {"default"}, "destination_pod": {"nginx-5d8-abcde"},
+		"port": {"80"},
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/evaluatenetworkpolicy", evaluate, nil)
+	if body["allowed"] != false {
+		t.Fatalf("expected connection denied by nginx-ingress, got %v", body)
+	}
+
+	policy.Ingress[0].Peers[0].PodSelector = map[string]string{"app": "nginx"}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updatenetworkpolicy", nil, policy)
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/evaluatenetworkpolicy", evaluate, nil)
+	if body["allowed"] != true {
+		t.Fatalf("expected connection allowed by nginx-ingress, got %v", body)
+	}
+
+	evaluate.Set("destination_pod", "missing")
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/evaluatenetworkpolicy", evaluate, nil)
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletenetworkpolicy", nil,
+		models.DeleteNetworkPolicyRequestModel{Namespace: "default", Name: "nginx-ingress"})
+}
+
+func TestRBACRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listroles",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "roles") != 1 {
+		t.Fatalf("expected one role, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listclusterroles",
+		url.Values{"sort_by": {"name"}}, nil)
+	if listLen(t, body, "roles") != 2 {
+		t.Fatalf("expected two cluster roles, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listrolebindings",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "bindings") != 2 {
+		t.Fatalf("expected two role bindings, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listclusterrolebindings", nil, nil)
+	if listLen(t, body, "bindings") != 1 {
+		t.Fatalf("expected one cluster role binding, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listserviceaccounts",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "service_accounts") != 1 {
+		t.Fatalf("expected one service account, got %v", body)
+	}
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/whocan", url.Values{
+		"verb": {"list"}, "resource": {"pods"}, "namespace": {"default"},
+	}, nil)
+	if listLen(t, body, "subjects") != 2 {
+		t.Fatalf("expected system:masters and jane, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/whocan", url.Values{
+		"verb": {"list"}, "resource": {"pods"}, "namespace": {"payments"},
+	}, nil)
+	if listLen(t, body, "subjects") != 1 {
+		t.Fatalf("expected only system:masters outside of default, got %v", body)
+	}
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/whocan",
+		url.Values{"verb": {"list"}}, nil)
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/subjectpermissions", url.Values{
+		"kind": {"ServiceAccount"}, "name": {"builder"}, "namespace": {"default"},
+	}, nil)
+	if listLen(t, body, "permissions") != 1 {
+		t.Fatalf("expected permissions of builder-deploys, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/subjectpermissions", url.Values{
+		"kind": {"User"}, "name": {"admin"}, "groups": {"system:masters"},
+	}, nil)
+	if listLen(t, body, "permissions") != 1 {
+		t.Fatalf("expected permissions of cluster-admin, got %v", body)
+	}
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/subjectpermissions",
+		url.Values{"kind": {"ServiceAccount"}, "name": {"builder"}}, nil)
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/reviewaccess", nil,
+		models.AccessReviewRequestModel{Verb: "delete", Resource: "pods", Namespace: "default"})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/reviewaccess", nil,
+		models.AccessReviewRequestModel{Verb: "delete"})
+}
+
+func TestPodMetricsRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getpodmetrics",
+		url.Values{"namespace": {"default"}}, nil)
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/getpodmetrics", nil, nil)
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v2/getpodmetrics", url.Values{
+		"namespace": {"default"}, "workload_kind": {"Deployment"}, "workload_name": {"nginx"},
+	}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v2/getpodmetrics",
+		url.Values{"workload_kind": {"CronJob"}}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v2/getpodmetrics",
+		url.Values{"start_time": {"yesterday"}}, nil)
+	end := time.Now().UTC()
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v2/getpodmetrics", url.Values{
+		"start_time": {end.Add(-5 * time.Hour).Format(time.RFC3339)}, "end_time": {end.Format(time.RFC3339)},
+	}, nil)
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletepodmetrics", nil,
+		models.DeletePodMetricsRequestModel{})
+}
+
+func TestWorkloadMetricsRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	end := time.Now().UTC()
+	timeRange := url.Values{
+		"start_time": {end.Add(-2 * time.Hour).Format(time.RFC3339)},
+		"end_time":   {end.Format(time.RFC3339)},
+	}
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getworkloadmetrics", timeRange, nil)
+	if body["interval"] != "1m0s" || listLen(t, body, "workloads") != 0 {
+		t.Fatalf("expected no workloads in one minute intervals, got %v", body)
+	}
+
+	timeRange.Set("group_by", "namespace")
+	timeRange.Set("interval", "1s")
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getworkloadmetrics", timeRange, nil)
+	timeRange.Set("interval", "5s")
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getworkloadmetrics", timeRange, nil)
+	timeRange.Set("interval", "15m")
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getworkloadmetrics", timeRange, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getworkloadmetrics",
+		url.Values{"end_time": {end.Format(time.RFC3339)}}, nil)
+}
+
+func TestNodeMetricsRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	end := time.Now().UTC()
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getnodemetrics", nil, nil)
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getnodemetrics", url.Values{
+		"node_name":  {"worker-1"},
+		"start_time": {end.Add(-24 * time.Hour).Format(time.RFC3339)},
+		"end_time":   {end.Format(time.RFC3339)},
+	}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getnodemetrics", url.Values{
+		"start_time": {end.Add(-24 * time.Hour).Format(time.RFC3339)},
+		"end_time":   {end.Format(time.RFC3339)},
+	}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/getnodemetrics",
+		url.Values{"end_time": {end.Format(time.RFC3339)}}, nil)
+}
+
+func TestServiceRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listservices",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "services") != 1 {
+		t.Fatalf("expected one service, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createservice", nil,
+		models.CreateServiceRequestModel{
+			Namespace: "default", Name: "api", Type: "NodePort",
+			Port: 80, TargetPort: 8080, NodePort: 30080,
+			Selector: map[string]string{"app": "api"},
+		})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deleteservice", nil,
+		models.DeleteServiceRequestModel{Namespace: "default", Name: "api"})
+}
+
+func TestIngressRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listingresses", nil, nil)
+	if listLen(t, body, "ingresses") != 1 {
+		t.Fatalf("expected one ingress, got %v", body)
+	}
+
+	ingress := models.CreateIngressRequestModel{
+		Namespace: "default", Name: "api",
+		Rules: []models.IngressRuleModel{{
+			Host: "api.example.com",
+			Paths: []models.IngressPathModel{
+				{Path: "/", ServiceName: "nginx", ServicePort: 80},
+			},
+		}},
+	}
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createingress", nil, ingress)
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/updateingress", nil,
+		models.UpdateIngressRequestModel(ingress))
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deleteingress", nil,
+		models.DeleteIngressRequestModel{Namespace: "default", Name: "api"})
+}
+
+func TestStorageRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listpersistentvolumeclaims",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "persistent_volume_claims") != 1 {
+		t.Fatalf("expected one claim, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listpersistentvolumes", nil, nil)
+	if listLen(t, body, "persistent_volumes") != 1 {
+		t.Fatalf("expected one volume, got %v", body)
+	}
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/liststorageclasses", nil, nil)
+	if listLen(t, body, "storage_classes") != 1 {
+		t.Fatalf("expected one storage class, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createpersistentvolumeclaim", nil,
+		models.CreatePersistentVolumeClaimRequestModel{
+			Namespace: "default", Name: "cache", StorageClass: "standard", Size: "5Gi",
+		})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/expandpersistentvolumeclaim", nil,
+		models.ExpandPersistentVolumeClaimRequestModel{Namespace: "default", Name: "data", Size: "2Gi"})
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/expandpersistentvolumeclaim", nil,
+		models.ExpandPersistentVolumeClaimRequestModel{Namespace: "default", Name: "data", Size: "512Mi"})
+	// statically bound claims don't fall back to the default class
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/expandpersistentvolumeclaim", nil,
+		models.ExpandPersistentVolumeClaimRequestModel{Namespace: "payments", Name: "static", Size: "2Gi"})
+}
+
+func TestEventRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listevents",
+		url.Values{"type": {"Warning"}}, nil)
+	if listLen(t, body, "events") != 1 {
+		t.Fatalf("expected one warning, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/geteventhistory",
+		url.Values{"namespace": {"default"}}, nil)
+}
+
+func TestDynamicResourceRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listapiresources", nil, nil)
+	if listLen(t, body, "resources") == 0 {
+		t.Fatalf("expected resources from discovery, got %v", body)
+	}
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listresources",
+		url.Values{"resource": {"pods"}, "namespace": {"default"}, "sort_by": {"restarts"}}, nil)
+	if listLen(t, body, "rows") != 1 {
+		t.Fatalf("expected one row, got %v", body)
+	}
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/listresources",
+		url.Values{"resource": {"widgets"}}, nil)
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getresource",
+		url.Values{"resource": {"configmaps"}, "namespace": {"default"}, "name": {"settings"}}, nil)
+	if body["kind"] != "ConfigMap" {
+		t.Fatalf("unexpected object %v", body)
+	}
+
+	manifest := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: flags\ndata:\n  beta: \"true\"\n"
+	body = s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/applymanifests", nil,
+		models.ApplyManifestsRequestModel{Manifest: manifest, Namespace: "default"})
+	if listLen(t, body, "objects") != 1 {
+		t.Fatalf("expected one applied object, got %v", body)
+	}
+	s.expect(t, http.StatusBadRequest, http.MethodPost, "/api/v1/applymanifests", nil,
+		models.ApplyManifestsRequestModel{Manifest: "kind: [", Namespace: "default"})
+
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deleteresource", nil,
+		models.DeleteResourceRequestModel{Resource: "configmaps", Namespace: "default", Name: "flags"})
+}
+
+func TestExportRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	resp := s.do(t, http.MethodGet, "/api/v1/exportresource", url.Values{
+		"group": {"apps"}, "resource": {"deployments"}, "namespace": {"default"}, "name": {"nginx"},
+	}, nil, s.token)
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(data), "kind: Deployment") {
+		t.Fatalf("expected deployment manifest, got %d: %s", resp.StatusCode, data)
+	}
+
+	resp = s.do(t, http.MethodGet, "/api/v1/exportnamespace",
+		url.Values{"namespace": {"default"}, "format": {"zip"}}, nil, s.token)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected namespace archive, got %d", resp.StatusCode)
+	}
+}
+
+func TestSnapshotRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/createsnapshot", nil,
+		models.CreateSnapshotRequestModel{Namespace: "default", Label: "before-migration"})
+	id := fmt.Sprint(body["id"])
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listsnapshots",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "snapshots") != 1 {
+		t.Fatalf("expected one snapshot, got %v", body)
+	}
+
+	s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/diffsnapshot", url.Values{"id": {id}}, nil)
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/diffsnapshot", url.Values{"id": {"999"}}, nil)
+
+	var snapshotID uint
+	fmt.Sscan(id, &snapshotID)
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/restoresnapshot", nil,
+		models.RestoreSnapshotRequestModel{ID: snapshotID, DryRun: "server"})
+	s.expect(t, http.StatusOK, http.MethodPost, "/api/v1/deletesnapshot", nil,
+		models.DeleteSnapshotRequestModel{ID: snapshotID})
+}
+
+func TestTopologyRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/getresourcetree", url.Values{
+		"resource": {"pods"}, "namespace": {"default"}, "name": {"nginx-5d8-abcde"},
+	}, nil)
+	if listLen(t, body, "nodes") < 3 {
+		t.Fatalf("expected pod with its owners, got %v", body)
+	}
+
+	body = s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/gettopology",
+		url.Values{"namespace": {"default"}}, nil)
+	if listLen(t, body, "edges") == 0 {
+		t.Fatalf("expected edges between objects, got %v", body)
+	}
+}
+
+func TestSearchRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/search",
+		url.Values{"image": {"nginx"}}, nil)
+	if listLen(t, body, "hits") != 2 {
+		t.Fatalf("expected pod and deployment using nginx, got %v", body)
+	}
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/search", nil, nil)
+}
+
+func TestWatchRoutes(t *testing.T) {
+
+	s := newTestServer(t)
+
+	// the stream itself never ends, only the errors before it starts are checked
+	s.expect(t, http.StatusBadRequest, http.MethodGet, "/api/v1/watch",
+		url.Values{"resources": {"secrets"}}, nil)
+	s.expect(t, http.StatusGone, http.MethodGet, "/api/v1/watch",
+		url.Values{"resource_version": {"100"}}, nil)
+}
+
+// API server allowing everything except proxy requests of impersonated
+// john other than get, proxied requests are echoed back as JSON and
+// echo upgrades send every line back
+var proxyBackend = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+	if r.URL.Path == "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews" {
+		review := authorizationapiv1.SelfSubjectAccessReview{}
+		_ = json.NewDecoder(r.Body).Decode(&review)
+		review.APIVersion = "authorization.k8s.io/v1"
+		review.Kind = "SelfSubjectAccessReview"
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = r.Header.Get("Impersonate-User") != "john" ||
+			(attributes.Verb == "get" && attributes.Resource != "secrets")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(review)
+		return
+	}
+
+	if r.Header.Get("Upgrade") == "echo" {
+		conn, rw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: echo\r\n\r\n")
+		rw.Flush()
+		line, _ := rw.ReadString('\n')
+		rw.WriteString(line)
+		rw.Flush()
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"method":        r.Method,
+		"path":          r.URL.Path,
+		"query":         r.URL.RawQuery,
+		"body":          string(body),
+		"authorization": r.Header.Get("Authorization"),
+		"impersonate":   r.Header.Get("Impersonate-User"),
+		"cookie":        r.Header.Get("Cookie"),
+		"custom":        r.Header.Get("X-Custom"),
+	})
+})
+
+// proxy request with raw body, client headers which must not be forwarded
+// are always sent, returns status and the decoded echo of the backend
+func (s *testServer) proxy(
+	t *testing.T, method string, target string, body string,
+) (int, map[string]string) {
+
+	t.Helper()
+
+	requestedRoutesMu.Lock()
+	requestedRoutes[method+" /api/v1/proxy/:kind/:namespace/:name/:port/*"] = true
+	requestedRoutesMu.Unlock()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	req.Header.Set("Impersonate-User", "admin")
+	req.Header.Set("Cookie", "session=secret")
+	req.Header.Set("X-Custom", "yes")
+
+	resp, err := s.app.Test(req, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	echo := map[string]string{}
+	_ = json.NewDecoder(resp.Body).Decode(&echo)
+
+	return resp.StatusCode, echo
+}
+
+func TestProxyRoutes(t *testing.T) {
+
+	s := newKubeconfigTestServer(t, proxyBackend, allowProxy)
+
+	status, echo := s.proxy(t, http.MethodPost,
+		"/api/v1/proxy/pods/default/nginx/8080/api/items?b=2&cluster=test&a=1", "hello")
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %v", status, echo)
+	}
+	expected := map[string]string{
+		"method":        http.MethodPost,
+		"path":          "/api/v1/namespaces/default/pods/nginx:8080/proxy/api/items",
+		"query":         "b=2&a=1",
+		"body":          "hello",
+		"authorization": "Bearer kubedash-token",
+		"impersonate":   "",
+		"cookie":        "",
+		"custom":        "yes",
+	}
+	for key, value := range expected {
+		if echo[key] != value {
+			t.Fatalf("expected %s %q, got %q", key, value, echo[key])
+		}
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		status, echo = s.proxy(t, method, "/api/v1/proxy/services/default/nginx/http/", "")
+		if status != http.StatusOK || echo["method"] != method ||
+			echo["path"] != "/api/v1/namespaces/default/services/nginx:http/proxy/" {
+			t.Fatalf("%s: unexpected proxied request %d: %v", method, status, echo)
+		}
+	}
+
+	// only proxied bodies may be bigger than the body limit
+	large := strings.Repeat("x", fiber.DefaultBodyLimit+1)
+	status, echo = s.proxy(t, http.MethodPut, "/api/v1/proxy/pods/default/nginx/8080/upload", large)
+	if status != http.StatusOK || len(echo["body"]) != len(large) {
+		t.Fatalf("expected whole body proxied, got %d with %d bytes", status, len(echo["body"]))
+	}
+	resp := s.do(t, http.MethodPost, "/api/v1/applymanifests", nil,
+		models.ApplyManifestsRequestModel{Manifest: large}, s.token)
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status 413 for body over the limit, got %d", resp.StatusCode)
+	}
+
+	status, _ = s.proxy(t, http.MethodGet, "/api/v1/proxy/secrets/default/nginx/8080/", "")
+	if status != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for unsupported kind, got %d", status)
+	}
+	status, _ = s.proxy(t, http.MethodGet, "/api/v1/proxy/pods/default/nginx/99999/", "")
+	if status != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for invalid port, got %d", status)
+	}
+}
+
+func TestProxyUpgrade(t *testing.T) {
+
+	s := newKubeconfigTestServer(t, proxyBackend, allowProxy)
+
+	// hijacking needs a real connection
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.app.Listener(ln, fiber.ListenConfig{DisableStartupMessage: true})
+	t.Cleanup(func() { _ = s.app.Shutdown() })
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	fmt.Fprintf(conn, "GET /api/v1/proxy/pods/default/nginx/8080/ws HTTP/1.1\r\n"+
+		"Host: kubedash\r\nAuthorization: Bearer %s\r\n"+
+		"Connection: Upgrade\r\nUpgrade: echo\r\n\r\n", s.token)
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Upgrade") != "echo" {
+		t.Fatalf("expected upgrade to echo, got %d: %v", resp.StatusCode, resp.Header)
+	}
+
+	fmt.Fprint(conn, "ping\n")
+	line, err := reader.ReadString('\n')
+	if err != nil || line != "ping\n" {
+		t.Fatalf("expected ping echoed, got %q: %v", line, err)
+	}
+}
+
+func TestProxyNotAllowed(t *testing.T) {
+
+	s := newKubeconfigTestServer(t, proxyBackend, nil)
+
+	status, _ := s.proxy(t, http.MethodGet, "/api/v1/proxy/pods/default/nginx/8080/", "")
+	if status != http.StatusForbidden {
+		t.Fatalf("expected status 403 without impersonation and -allow-proxy, got %d", status)
+	}
+}
+
+func TestProxyImpersonation(t *testing.T) {
+
+	s := newKubeconfigTestServer(t, proxyBackend, impersonate)
+
+	status, echo := s.proxy(t, http.MethodGet, "/api/v1/proxy/pods/default/nginx/8080/", "")
+	if status != http.StatusOK || echo["impersonate"] != "john" {
+		t.Fatalf("expected request made as john, got %d: %v", status, echo)
+	}
+
+	status, _ = s.proxy(t, http.MethodPost, "/api/v1/proxy/pods/default/nginx/8080/", "")
+	if status != http.StatusForbidden {
+		t.Fatalf("expected status 403 without create on pods/proxy, got %d", status)
+	}
+}
+
+func TestCollectedDataImpersonation(t *testing.T) {
+
+	s := newKubeconfigTestServer(t, proxyBackend, impersonate)
+
+	// john can get anything but secrets and can't list anything
+	hour := url.Values{
+		"start_time": {time.Now().Add(-time.Hour).Format(time.RFC3339)},
+		"end_time":   {time.Now().Format(time.RFC3339)},
+	}
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v2/getpodmetrics", nil, nil)
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v1/getnodemetrics", nil, nil)
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v1/getworkloadmetrics", hour, nil)
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v1/geteventhistory",
+		url.Values{"namespace": {"default"}}, nil)
+
+	snapshot := models.DBSnapshotModel{Cluster: testClusterName, Namespace: "default"}
+	if err := s.db.Create(&snapshot).Error; err != nil {
+		t.Fatal(err)
+	}
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listsnapshots", nil, nil)
+	if listLen(t, body, "snapshots") != 0 {
+		t.Fatalf("expected snapshot of unreadable namespace to be left out, got %v", body)
+	}
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v1/listsnapshots",
+		url.Values{"namespace": {"default"}}, nil)
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v1/diffsnapshot",
+		url.Values{"id": {fmt.Sprint(snapshot.ID)}}, nil)
+	s.expect(t, http.StatusForbidden, http.MethodPost, "/api/v1/deletesnapshot", nil,
+		models.DeleteSnapshotRequestModel{ID: snapshot.ID})
+	// snapshot created without secrets would be incomplete
+	s.expect(t, http.StatusForbidden, http.MethodPost, "/api/v1/createsnapshot", nil,
+		models.CreateSnapshotRequestModel{Namespace: "default", Label: "before-migration"})
+}
+
+// API server with discovery of the core group answering table requests
+// of secrets, impersonated users are forbidden to list them
+var secretsBackend = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+	var response interface{}
+	switch r.URL.Path {
+	case "/api":
+		response = metaapiv1.APIVersions{
+			TypeMeta: metaapiv1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		}
+	case "/apis":
+		response = metaapiv1.APIGroupList{TypeMeta: metaapiv1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	case "/api/v1":
+		response = metaapiv1.APIResourceList{
+			TypeMeta:     metaapiv1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: "v1",
+			APIResources: []metaapiv1.APIResource{
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		}
+	case "/api/v1/namespaces/default/secrets":
+		if user := r.Header.Get("Impersonate-User"); user != "" {
+			status := apierrors.NewForbidden(
+				schema.GroupResource{Resource: "secrets"}, "", fmt.Errorf("user %s can't list secrets", user),
+			).ErrStatus
+			status.Kind, status.APIVersion = "Status", "v1"
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(status)
+			return
+		}
+		response = metaapiv1.Table{
+			TypeMeta:          metaapiv1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "Table"},
+			ColumnDefinitions: []metaapiv1.TableColumnDefinition{{Name: "Name", Type: "string"}},
+			Rows:              []metaapiv1.TableRow{{Cells: []interface{}{"token"}}},
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+})
+
+func TestListResourcesImpersonation(t *testing.T) {
+
+	secrets := url.Values{"resource": {"secrets"}, "namespace": {"default"}}
+
+	s := newKubeconfigTestServer(t, secretsBackend, nil)
+	body := s.expect(t, http.StatusOK, http.MethodGet, "/api/v1/listresources", secrets, nil)
+	if listLen(t, body, "rows") != 1 {
+		t.Fatalf("expected secret listed with the server's credentials, got %v", body)
+	}
+
+	// the table is requested as the user, not with discovery of the server
+	s = newKubeconfigTestServer(t, secretsBackend, impersonate)
+	s.expect(t, http.StatusForbidden, http.MethodGet, "/api/v1/listresources", secrets, nil)
+}
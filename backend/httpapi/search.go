@@ -0,0 +1,49 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        Search Resources
+// @Description    Search pods, workloads, services, ingresses, config maps, claims, namespaces and nodes in all namespaces by name substring, label selector, annotation and container image. All given criteria have to match. Served from the resource cache, secrets are not searchable.
+// @Tags           Search
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.SearchRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.SearchResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Failure        503
+// @Router         /api/v1/search [get]
+func ApiV1Search(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.SearchRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		hits, err := controller.Search(cluster, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(hits)
+	}
+}
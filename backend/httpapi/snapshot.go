@@ -0,0 +1,212 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"gorm.io/gorm"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        Create Namespace Snapshot
+// @Description    Save all objects of the namespace created by users (deployments, services, configmaps, secrets, ingresses, CRDs, ...) to the database as a save point. Secrets are stored encrypted with the server secret key. Fails with 403 when the user can't get secrets of the namespace, same as reading snapshots.
+// @Tags           Snapshots
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.CreateSnapshotRequestModel   true   "Request Model of Create Snapshot"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  models.SnapshotModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createsnapshot [post]
+func ApiV1CreateSnapshot(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.CreateSnapshotRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		snapshot, err := controller.CreateSnapshot(cluster, db, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(snapshot)
+	}
+}
+
+// @Summary        List Namespace Snapshots
+// @Description    Get snapshots stored in the database, most recent first. Snapshots hold secrets of their namespace, only snapshots of namespaces the user can get secrets in are returned.
+// @Tags           Snapshots
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListSnapshotsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListSnapshotsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listsnapshots [get]
+func ApiV1ListSnapshots(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListSnapshotsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		snapshots, err := controller.ListSnapshots(cluster, db, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(snapshots)
+	}
+}
+
+// @Summary        Diff Namespace Snapshot
+// @Description    Compare the snapshot with the live state of its namespace. Returns the changes restore would make and objects created after the snapshot. The user needs the get verb on secrets of the namespace.
+// @Tags           Snapshots
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.DiffSnapshotRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.DiffSnapshotResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/diffsnapshot [get]
+func ApiV1DiffSnapshot(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DiffSnapshotRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		diff, err := controller.DiffSnapshot(cluster, db, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(diff)
+	}
+}
+
+// @Summary        Restore Namespace Snapshot
+// @Description    Re-create or reconcile every object of the snapshot with server-side apply, the namespace is created if it doesn't exist. Objects created after the snapshot are left untouched. Use dry_run=server to preview the results.
+// @Tags           Snapshots
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.RestoreSnapshotRequestModel   true   "Request Model of Restore Snapshot"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  models.ApplyManifestsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/restoresnapshot [post]
+func ApiV1RestoreSnapshot(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.RestoreSnapshotRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		results, err := controller.RestoreSnapshot(cluster, db, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(results)
+	}
+}
+
+// @Summary        Delete Namespace Snapshot
+// @Description    Removes the snapshot and all its objects from the database. The user needs the get verb on secrets of the namespace.
+// @Tags           Snapshots
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.DeleteSnapshotRequestModel   true   "Request Model of Delete Snapshot"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/deletesnapshot [post]
+func ApiV1DeleteSnapshot(clusters *controller.ClusterRegistry, db *gorm.DB) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.DeleteSnapshotRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.DeleteSnapshot(cluster, db, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "snapshot deleted"})
+	}
+}
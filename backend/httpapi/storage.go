@@ -0,0 +1,210 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        List Persistent Volume Claims
+// @Description    Get persistent volume claims with their binding status, capacity and pods mounting them
+// @Tags           Storage
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListPersistentVolumeClaimsRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListPersistentVolumeClaimsResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listpersistentvolumeclaims [get]
+func ApiV1ListPersistentVolumeClaims(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListPersistentVolumeClaimsRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		claims, err := controller.ListPersistentVolumeClaims(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(claims)
+	}
+}
+
+// @Summary        List Persistent Volumes
+// @Description    Get all persistent volumes in the cluster with the claims bound to them
+// @Tags           Storage
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListPersistentVolumesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListPersistentVolumesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/listpersistentvolumes [get]
+func ApiV1ListPersistentVolumes(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListPersistentVolumesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		volumes, err := controller.ListPersistentVolumes(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(volumes)
+	}
+}
+
+// @Summary        List Storage Classes
+// @Description    Get all storage classes in the cluster
+// @Tags           Storage
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.ListStorageClassesRequestModel   false   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ListStorageClassesResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/liststorageclasses [get]
+func ApiV1ListStorageClasses(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ListStorageClassesRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		classes, err := controller.ListStorageClasses(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(classes)
+	}
+}
+
+// @Summary        Create Persistent Volume Claim
+// @Description    Creates persistent volume claim of the given size and storage class
+// @Tags           Storage
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.CreatePersistentVolumeClaimRequestModel   true   "Request Model of Create Persistent Volume Claim"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/createpersistentvolumeclaim [post]
+func ApiV1CreatePersistentVolumeClaim(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.CreatePersistentVolumeClaimRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.CreatePersistentVolumeClaim(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "persistent volume claim created"})
+	}
+}
+
+// @Summary        Expand Persistent Volume Claim
+// @Description    Increases the requested size of the claim. Fails with 400 when the storage class doesn't allow volume expansion or the size isn't bigger than the current one. Claims without storage class use the class of their bound volume and can't be expanded while unbound.
+// @Tags           Storage
+// @Security       ApiKeyAuth
+// @Accept         json
+// @Param          request   body   models.ExpandPersistentVolumeClaimRequestModel   true   "Request Model of Expand Persistent Volume Claim"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Produce        json
+// @Success        200   {object}  object  "Success"
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/expandpersistentvolumeclaim [post]
+func ApiV1ExpandPersistentVolumeClaim(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.ExpandPersistentVolumeClaimRequestModel)
+		err = parseValidateParams(&c, req, true)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		err = controller.ExpandPersistentVolumeClaim(cluster.Clientset, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(fiber.Map{"status": "persistent volume claim expanded"})
+	}
+}
@@ -0,0 +1,88 @@
+package httpapi
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// @Summary        Get Resource Tree
+// @Description    Get ownership tree of any object as nodes and edges. The tree starts at the top level owner of the object, ex. Deployment -> ReplicaSets -> Pods when asked for a pod.
+// @Tags           Topology
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.GetResourceTreeRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.ResourceTreeResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/getresourcetree [get]
+func ApiV1GetResourceTree(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetResourceTreeRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		tree, err := controller.GetResourceTree(cluster.Dynamic, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(tree)
+	}
+}
+
+// @Summary        Get Namespace Topology
+// @Description    Get graph of the namespace as nodes and edges: ingresses route to services, services select pods, workloads own pods and pods mount or reference config maps, secrets and persistent volume claims. Referenced objects which don't exist are included with Missing status. Kinds the user can't list are left out, objects of such kinds referenced by pods are included with Unknown status. Config maps and secrets are read without their data.
+// @Tags           Topology
+// @Security       ApiKeyAuth
+// @Produce        json
+// @Param          request   query   models.GetTopologyRequestModel   true   "Query parameters"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.TopologyResponseModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        500
+// @Router         /api/v1/gettopology [get]
+func ApiV1GetTopology(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.GetTopologyRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		topology, err := controller.GetTopology(cluster.Clientset, cluster.Metadata, req)
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		return c.JSON(topology)
+	}
+}
@@ -0,0 +1,128 @@
+package httpapi
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/kube-dash/kube-dash-backend/controller"
+	"github.com/kube-dash/kube-dash-backend/models"
+)
+
+// how often ping is sent to keep idle streams (and proxies) alive
+const watchPingInterval = 15 * time.Second
+
+func writeWatchEvent(w *bufio.Writer, event *models.WatchEventModel) error {
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", event.ResourceVersion, data)
+	return err
+}
+
+// @Summary        Watch Resource Changes
+// @Description    Stream ADDED, MODIFIED and DELETED changes of pods, deployments, services and events as server-sent events. Objects are summarized the same way as by the list endpoints. Every change has increasing id, reconnecting EventSource resumes with Last-Event-ID automatically. Too old (or unknown after restart) resource version returns 410, list again and watch without it. Ping event is sent every 15 seconds.
+// @Tags           Watch
+// @Security       ApiKeyAuth
+// @Produce        text/event-stream
+// @Param          request   query   models.WatchRequestModel   false   "Query parameters"
+// @Param          Last-Event-ID   header   string   false   "Resume after this resource version"
+// @Param          cluster   query   string   false   "Name of the cluster, the default cluster when empty"
+// @Success        200                {object}    models.WatchEventModel
+// @Failure        400
+// @Failure        401
+// @Failure        403
+// @Failure        404
+// @Failure        410
+// @Failure        503
+// @Router         /api/v1/watch [get]
+func ApiV1Watch(clusters *controller.ClusterRegistry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+
+		cluster, err := getCluster(&c, clusters)
+		if err != nil {
+			// will return error status set in getCluster
+			return nil
+		}
+
+		req := new(models.WatchRequestModel)
+		err = parseValidateParams(&c, req, false)
+		if err != nil {
+			// will return status bad request set in parseValidateBody
+			return nil
+		}
+
+		if lastEventID := c.Get("Last-Event-ID"); req.ResourceVersion == 0 && lastEventID != "" {
+			req.ResourceVersion, err = strconv.ParseUint(lastEventID, 10, 64)
+			if err != nil {
+				makeBR(&c, errors.New("unable to parse Last-Event-ID"))
+				return nil
+			}
+		}
+
+		stream, missed, err := controller.Watch(cluster, req)
+		if errors.Is(err, controller.ErrWatchExpired) {
+			c.Status(fiber.StatusGone).JSON(fiber.Map{"error": err.Error()})
+			return nil
+		}
+		if err != nil {
+			makeError(&c, err)
+			return nil
+		}
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderConnection, "keep-alive")
+		// disable response buffering of nginx
+		c.Set("X-Accel-Buffering", "no")
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer stream.Close()
+
+			// tell the client where the stream starts so it can resume
+			// even before the first change
+			_, err := fmt.Fprintf(w, "id: %d\nevent: ready\ndata: {}\n\n", stream.Version)
+			if err != nil {
+				return
+			}
+			for i := range missed {
+				if writeWatchEvent(w, &missed[i]) != nil {
+					return
+				}
+			}
+			if w.Flush() != nil {
+				return
+			}
+
+			ticker := time.NewTicker(watchPingInterval)
+			defer ticker.Stop()
+
+			// write fails once the client disconnects
+			for {
+				select {
+				case event, ok := <-stream.Events:
+					if !ok {
+						// too slow, the client reconnects and resumes
+						return
+					}
+					err = writeWatchEvent(w, &event)
+				case now := <-ticker.C:
+					_, err = fmt.Fprintf(w, "event: ping\ndata: {\"time\": %q}\n\n", now.UTC().Format(time.RFC3339))
+				}
+				if err != nil || w.Flush() != nil {
+					return
+				}
+			}
+		})
+
+		return nil
+	}
+}
@@ -1,16 +1,14 @@
 package main
 
 import (
-	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"strings"
 
-	jwtware "github.com/gofiber/contrib/jwt"
 	swagger "github.com/gofiber/swagger"
 	_ "github.com/kube-dash/kube-dash-backend/docs"
 
-	"github.com/go-playground/validator/v10"
 	fiber "github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/cors"
 	"github.com/gofiber/fiber/v3/middleware/logger"
@@ -21,38 +19,6 @@ import (
 	httpapi "github.com/kube-dash/kube-dash-backend/httpapi"
 )
 
-type structValidator struct {
-	validate *validator.Validate
-}
-
-func (v *structValidator) Validate(out any) error {
-
-	err := v.validate.Struct(out)
-	if err == nil {
-		return nil
-	}
-
-	// maybe there was an actual error with validation
-	// in this case also return error
-	if _, ok := err.(*validator.InvalidValidationError); ok {
-		return err
-	}
-
-	// take the first parameter error
-	validationErrors := err.(validator.ValidationErrors)
-	firstErr := validationErrors[0]
-
-	return errors.New(firstErr.StructField())
-
-}
-
-func JWTErrorHandler(c fiber.Ctx, err error) error {
-
-	return c.Status(fiber.StatusUnauthorized).JSON(
-		fiber.Map{"error": err.Error()},
-	)
-}
-
 // @title kube-dash backend
 // @version 1.0
 // @description Backend API of kube-dash
@@ -65,15 +31,21 @@ func JWTErrorHandler(c fiber.Ctx, err error) error {
 // @BasePath /
 func main() {
 
-	app := fiber.New(fiber.Config{
-		StructValidator: &structValidator{validate: validator.New()},
-	})
+	app := httpapi.NewApp()
 	app.Use(logger.New())
 	app.Use(cors.New())
 
 	kubeconfigPath := flag.String(
-		"kubeconfig", "kube-config.yml",
-		"The path to kubeconfig file of the cluster",
+		"kubeconfig", "",
+		"The path to kubeconfig file or a directory of kubeconfig files, "+
+			"every context is served as a separate cluster, "+
+			"the service account of the pod is used when empty",
+	)
+
+	defaultCluster := flag.String(
+		"cluster", "",
+		"Name of the cluster used when requests don't name one, "+
+			"the current context of the kubeconfig by default",
 	)
 
 	secretkeyPath := flag.String(
@@ -81,18 +53,47 @@ func main() {
 		"The path to server secret key used for JWT generation",
 	)
 
+	impersonate := flag.Bool(
+		"impersonate", false,
+		"Make Kubernetes requests as the logged in user so the cluster RBAC applies",
+	)
+	impersonateGroups := flag.String(
+		"impersonate-groups", "",
+		"Comma separated groups sent with every impersonated user",
+	)
+	allowProxy := flag.Bool(
+		"allow-proxy", false,
+		"Proxy requests to pods and services of every logged in user "+
+			"without impersonation, with the server's own credentials",
+	)
+
 	devMode := flag.Bool("dev", false, "Run in development mode")
 	swagMode := flag.Bool("swag", false, "Register /swagger endpoint")
 
 	flag.Parse()
 
-	clientset, metricsset, err := controller.NewClientSet(*kubeconfigPath)
+	clusters, err := controller.NewClusterRegistry(*kubeconfigPath, *defaultCluster)
 	if err != nil {
-		log.Fatal("could not initialize clientset, " +
-			"point -kubeconfig parameter to your kubernetes config.yml",
+		log.Fatal("could not initialize clusters, "+
+			"point -kubeconfig parameter to your kubernetes config.yml "+
+			"or run the server in a pod: ", err,
 		)
 	}
 
+	if *impersonate {
+		if *devMode {
+			log.Fatal("-impersonate needs logged in users, it can't be used with -dev")
+		}
+		clusters.Impersonate = true
+		for _, group := range strings.Split(*impersonateGroups, ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				clusters.ImpersonateGroups = append(clusters.ImpersonateGroups, group)
+			}
+		}
+	}
+
+	clusters.AllowProxy = *allowProxy
+
 	err = common.InitSSK(*secretkeyPath)
 	if err != nil {
 		log.Fatal(
@@ -105,13 +106,35 @@ func main() {
 		log.Fatal(err)
 	}
 
-	db, err := database.InitDB()
+	db, err := database.InitDB("database.db")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	database.StartDBPodMetricsCleaner(db)
-	controller.StartPodMetricsMonitor(metricsset, db)
+	// records stored before the server knew about clusters
+	// belong to the default one
+	err = database.AssignDefaultCluster(db, clusters.Default)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	database.StartDBMetricsCleaner(db)
+	database.StartDBEventsCleaner(db)
+	for _, cluster := range clusters.Clusters() {
+		// pod metrics stored before their namespace was recorded, an
+		// unreachable cluster leaves them for the next start
+		err = controller.MigratePodMetrics(cluster, db)
+		if err != nil {
+			log.Printf("unable to migrate pod metrics of cluster %s: %v", cluster.Name, err)
+		}
+
+		controller.StartMetricsMonitor(cluster, db)
+		controller.StartEventsMonitor(cluster.Clientset, cluster.Name, db)
+
+		// shared informers serving list endpoints and search, the channel is
+		// never closed as the cache lives as long as the server
+		cluster.Cache.Start(make(chan struct{}))
+	}
 
 	// make sure to close the DB when the main goes out of scope
 	defer func() {
@@ -119,38 +142,7 @@ func main() {
 		_ = dbIns.Close()
 	}()
 
-	// Login route
-	app.Post("/api/v1/login", httpapi.ApiV1Login)
-
-	app.Get("/api/v1/accessible", httpapi.ApiV1Accessible)
-
-	// JWT Middleware
-	if !(*devMode) {
-		app.Use(jwtware.New(jwtware.Config{
-			SigningKey:   jwtware.SigningKey{Key: ssk},
-			ErrorHandler: JWTErrorHandler,
-		}))
-	}
-
-	// Restricted Routes
-	app.Get("/api/v1/restricted", httpapi.ApiV1Restricted)
-	app.Get("/api/v1/listpods", httpapi.ApiV1ListPods(clientset))
-	app.Get("/api/v2/listpods", httpapi.ApiV2ListPods(clientset))
-	app.Get("/api/v1/listcontainers", httpapi.ApiV1ListContainers(clientset))
-	app.Get("/api/v1/listnamespaces", httpapi.ApiV1ListNamespaces(clientset))
-
-	app.Get("/api/v1/listdeployments", httpapi.ApiV1ListDeployments(clientset))
-	app.Post("/api/v1/createdeployment", httpapi.ApiV1CreateDeployment(clientset))
-	app.Post("/api/v1/updatedeployment", httpapi.ApiV1UpdateDeployment(clientset))
-	app.Post("/api/v1/deletedeployment", httpapi.ApiV1DeleteDeployment(clientset))
-
-	app.Get("/api/v1/getpodmetrics", httpapi.ApiV1GetPodMetrics(metricsset))
-	app.Get("/api/v2/getpodmetrics", httpapi.ApiV2GetPodMetrics(metricsset, db))
-	app.Post("/api/v1/deletepodmetrics", httpapi.ApiV1DeletePodMetrics(db))
-
-	app.Post("/api/v1/createservice", httpapi.ApiV1CreateService(clientset))
-	app.Get("/api/v1/listservices", httpapi.ApiV1ListServices(clientset))
-	app.Post("/api/v1/deleteservice", httpapi.ApiV1DeleteService(clientset))
+	httpapi.RegisterRoutes(app, clusters, db, ssk, *devMode)
 
 	if *swagMode {
 		app.Get("/swagger/*", swagger.New(swagger.Config{
@@ -30,16 +30,118 @@ type DBContainerMetricsModel struct {
 
 type DBPodMetricsModel struct {
 	DBCustomModel
+	// Namespace of the pod, empty for records stored before namespaces were recorded whose pod couldn't be identified.
+	Namespace string `gorm:"index:idx_pod_metrics_pod" json:"namespace" example:"default"`
 	// Name of the pod.
-	Name string `json:"name" example:"mypod"`
+	Name string `gorm:"index:idx_pod_metrics_pod" json:"name" example:"mypod"`
+	// UID of the pod, tells apart pods recreated with the same name, empty for records stored before UIDs were recorded.
+	UID string `gorm:"index" json:"uid" example:"3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"`
+	// Node the pod runs on.
+	Node string `gorm:"index" json:"node" example:"worker-1"`
+	// Kind of the workload owning the pod (ex. Deployment or StatefulSet), empty for pods without controller.
+	WorkloadKind string `gorm:"index:idx_pod_metrics_workload" json:"workload_kind" example:"Deployment"`
+	// Name of the workload owning the pod.
+	WorkloadName string `gorm:"index:idx_pod_metrics_workload" json:"workload_name" example:"nginx-deployment"`
 	// Metrics records grouped by containers.
 	Containers []DBContainerMetricsModel `gorm:"foreignKey:PodID" json:"containers"`
 	// Foreign key that references ClusterMetricsModel's ID field to make the relationship between pods and clusters.
-	CRecID uint `json:"-"`
+	CRecID uint `gorm:"index" json:"-"`
 }
 
 type DBClusterMetricsModel struct {
 	DBCustomModel
+	// Name of the cluster the metrics were collected from.
+	Cluster string `gorm:"index" json:"cluster" example:"production"`
 	// Timestamp indicating when the record was created. This is the time when the metrics were collected.
 	Pods []DBPodMetricsModel `gorm:"foreignKey:CRecID" json:"pods"`
 }
+
+type DBNodeMetricsModel struct {
+	DBCustomModel
+	// Name of the node.
+	Name string `json:"name" example:"worker-1"`
+	// Time the usage was measured by the metrics server.
+	Timestamp time.Time `json:"timestamp" example:"2024-08-24T20:00:00.000Z"`
+	// CPU usage of the node in millicores (1/1000th of a core).
+	CPUUsage int64 `json:"cpu_usage" example:"1250"`
+	// Memory usage of the node in bytes.
+	MemoryUsage int64 `json:"memory_usage" example:"3221225472"`
+	// CPU of the node allocatable by pods in millicores.
+	CPUAllocatable int64 `json:"cpu_allocatable" example:"3800"`
+	// Memory of the node allocatable by pods in bytes.
+	MemoryAllocatable int64 `json:"memory_allocatable" example:"7516192768"`
+	// Foreign key that references DBClusterNodeMetricsModel's ID field to make the relationship between nodes and clusters.
+	NRecID uint `gorm:"index" json:"-"`
+}
+
+type DBClusterNodeMetricsModel struct {
+	DBCustomModel
+	// Name of the cluster the metrics were collected from.
+	Cluster string `gorm:"index" json:"cluster" example:"production"`
+	// Metrics records of the nodes.
+	Nodes []DBNodeMetricsModel `gorm:"foreignKey:NRecID" json:"nodes"`
+}
+
+type DBEventModel struct {
+	DBCustomModel
+	// UID of the kubernetes event, used for deduplication.
+	UID string `gorm:"uniqueIndex" json:"uid" example:"3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"`
+	// Name of the cluster the event comes from, set only for stored events.
+	Cluster string `gorm:"index" json:"cluster,omitempty" example:"production"`
+	// Namespace of the event.
+	Namespace string `gorm:"index" json:"namespace" example:"default"`
+	// Kind of the object the event is about.
+	InvolvedKind string `gorm:"index:idx_event_involved" json:"involved_kind" example:"Pod"`
+	// Name of the object the event is about.
+	InvolvedName string `gorm:"index:idx_event_involved" json:"involved_name" example:"nginx-deploy-59849dcb58-tdknv"`
+	// Type of the event (Normal or Warning).
+	Type string `json:"type" example:"Warning"`
+	// Machine readable reason of the event.
+	Reason string `json:"reason" example:"BackOff"`
+	// Human readable description of the event.
+	Message string `json:"message" example:"Back-off restarting failed container"`
+	// Component that reported the event.
+	Source string `json:"source" example:"kubelet"`
+	// How many times the event occurred.
+	Count int32 `json:"count" example:"5"`
+	// The time the event was first seen.
+	FirstTimestamp time.Time `json:"first_timestamp" example:"2024-08-24T20:00:00.000Z"`
+	// The time the event was last seen.
+	LastTimestamp time.Time `gorm:"index" json:"last_timestamp" example:"2024-08-24T20:05:00.000Z"`
+}
+
+type DBSnapshotModel struct {
+	DBCustomModel
+	// Name of the cluster the snapshot was taken in.
+	Cluster string `gorm:"index" json:"cluster" example:"production"`
+	// Namespace the snapshot was taken of.
+	Namespace string `gorm:"index" json:"namespace" example:"default"`
+	// Label given to the snapshot by the user.
+	Label string `json:"label" example:"before-migration"`
+	// Objects captured in the snapshot.
+	Objects []DBSnapshotObjectModel `gorm:"foreignKey:SnapshotID" json:"objects"`
+}
+
+type DBSnapshotObjectModel struct {
+	DBCustomModel
+	// API version of the object.
+	APIVersion string `json:"api_version" example:"apps/v1"`
+	// Kind of the object.
+	Kind string `json:"kind" example:"Deployment"`
+	// Name of the object.
+	Name string `json:"name" example:"nginx-deployment"`
+	// Manifest of the object as JSON, without namespace and fields assigned by the cluster.
+	Manifest []byte `json:"-"`
+	// Whether the manifest is encrypted with the server secret key (secrets).
+	Encrypted bool `json:"-"`
+	// Foreign key that references DBSnapshotModel's ID field.
+	SnapshotID uint `gorm:"index" json:"-"`
+}
+
+type DBMigrationModel struct {
+	DBCustomModel
+	// Name of the data migration.
+	Name string `gorm:"uniqueIndex:idx_migration" json:"name" example:"pod_metrics_identity"`
+	// Name of the cluster the migration was done for.
+	Cluster string `gorm:"uniqueIndex:idx_migration" json:"cluster" example:"production"`
+}
@@ -7,26 +7,54 @@ type LoginModel struct {
 	Pass string `json:"pass" validate:"required"`
 }
 
+// filtering, pagination and sorting shared by list endpoints
+type ListOptionsModel struct {
+	// Label selector to filter objects
+	LabelSelector string `query:"label_selector" example:"app=nginx,tier!=cache"`
+	// Field selector to filter objects, fields supported depend on the resource
+	FieldSelector string `query:"field_selector" example:"metadata.name=nginx"`
+	// Maximum number of objects in the page, all objects are returned when empty
+	Limit int64 `query:"limit" validate:"gte=0,lte=1000" example:"100"`
+	// Token of the next page returned in pagination of the previous page
+	Continue string `query:"continue" example:"eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9"`
+	// Sort key (name, age, status or restarts), objects are sorted by namespace and name when empty
+	SortBy string `query:"sort_by" validate:"omitempty,oneof=name age status restarts" example:"age"`
+	// Sort order (asc or desc, default: asc), ascending age starts with the youngest objects
+	Order string `query:"order" validate:"omitempty,oneof=asc desc" example:"desc"`
+}
+
 type ListPodsV1RequestModel struct {
+	ListOptionsModel
 	// Namespace to filter pods
 	Namespace string `query:"namespace" example:"default"`
+	// Read directly from the API server instead of the cache
+	Fresh bool `query:"fresh" example:"false"`
 }
 
 type ListPodsV2RequestModel struct {
+	ListOptionsModel
 	// Namespace to filter pods
 	Namespace string `query:"namespace" example:"default"`
+	// Read directly from the API server instead of the cache
+	Fresh bool `query:"fresh" example:"false"`
 }
 
 type ListContainersRequestModel struct {
+	ListOptionsModel
 	// Namespace of the pod containing the containers
 	Namespace string `query:"namespace" example:"default"`
 	// Name of the pod containing the containers
 	PodName string `query:"pod_name" example:"mypod"`
+	// Read directly from the API server instead of the cache
+	Fresh bool `query:"fresh" example:"false"`
 }
 
 type ListDeploymentsRequestModel struct {
+	ListOptionsModel
 	// Namespace to filter deployments
 	Namespace string `query:"namespace" example:"default"`
+	// Read directly from the API server instead of the cache
+	Fresh bool `query:"fresh" example:"false"`
 }
 
 type CreateDeploymentRequestModel struct {
@@ -46,6 +74,8 @@ type CreateDeploymentRequestModel struct {
 	CPULimit string `json:"cpu_limit" example:"200m"`
 	// Memory limit for each pod in the deployment (default: 512Mi)
 	MemoryLimit string `json:"memory_limit" example:"512Mi"`
+	// Only preview the changes with server-side dry run and return their diff
+	Diff bool `json:"diff" example:"false"`
 }
 
 // TODO: code dup but don't know how to avoid it here
@@ -65,6 +95,8 @@ type UpdateDeploymentRequestModel struct {
 	CPULimit string `json:"cpu_limit" example:"200m"`
 	// Memory limit for each pod in the deployment
 	MemoryLimit string `json:"memory_limit" example:"512Mi"`
+	// Only preview the changes with server-side dry run and return their diff
+	Diff bool `json:"diff" example:"false"`
 }
 
 type DeleteDeploymentRequestModel struct {
@@ -72,6 +104,8 @@ type DeleteDeploymentRequestModel struct {
 	Namespace string `json:"namespace" validate:"required" example:"default"`
 	// Name of the deployment to delete
 	Name string `json:"name" validate:"required" example:"mydeployment"`
+	// Only preview the changes with server-side dry run and return their diff
+	Diff bool `json:"diff" example:"false"`
 }
 
 type GetPodMetricsV1RequestModel struct {
@@ -82,12 +116,35 @@ type GetPodMetricsV1RequestModel struct {
 type GetPodMetricsV2RequestModel struct {
 	// Name of the pod for which to retrieve metrics
 	PodName string `query:"pod_name" example:"mypod"`
+	// Namespace of the pods for which to retrieve metrics
+	Namespace string `query:"namespace" example:"default"`
+	// Kind of the workload owning the pods (Deployment, StatefulSet, DaemonSet, Job or ReplicaSet)
+	WorkloadKind string `query:"workload_kind" validate:"omitempty,oneof=Deployment StatefulSet DaemonSet Job ReplicaSet" example:"Deployment"`
+	// Name of the workload owning the pods
+	WorkloadName string `query:"workload_name" example:"nginx-deployment"`
 	// Start time for metric collection in RFC3339 format
 	StartTime string `query:"start_time" example:"2024-08-24T20:00:00.000Z"`
 	// End time for metric collection in RFC3339 format
 	EndTime string `query:"end_time" example:"2024-08-24T20:30:00.000Z"`
 }
 
+type GetWorkloadMetricsRequestModel struct {
+	// Aggregate usage per workload or per namespace (default: workload)
+	GroupBy string `query:"group_by" validate:"omitempty,oneof=workload namespace" example:"workload"`
+	// Namespace of the pods to aggregate
+	Namespace string `query:"namespace" example:"default"`
+	// Kind of the workloads to aggregate (Deployment, StatefulSet, DaemonSet, Job or ReplicaSet)
+	WorkloadKind string `query:"workload_kind" validate:"omitempty,oneof=Deployment StatefulSet DaemonSet Job ReplicaSet" example:"Deployment"`
+	// Name of the workload to aggregate
+	WorkloadName string `query:"workload_name" example:"nginx-deployment"`
+	// Start time of the aggregated time range in RFC3339 format
+	StartTime string `query:"start_time" validate:"required" example:"2024-08-24T20:00:00.000Z"`
+	// End time of the aggregated time range in RFC3339 format
+	EndTime string `query:"end_time" validate:"required" example:"2024-08-24T22:00:00.000Z"`
+	// Length of the aggregation intervals as duration, at least 5s (default: 1m)
+	Interval string `query:"interval" example:"5m"`
+}
+
 type DeletePodMetricsRequestModel struct {
 	// Start time of metrics to delete in RFC3339 format
 	StartTime string `json:"start_time" example:"2024-08-24T20:00:00.000Z"`
@@ -95,6 +152,15 @@ type DeletePodMetricsRequestModel struct {
 	EndTime string `json:"end_time" example:"2024-08-24T20:30:00.000Z"`
 }
 
+type GetNodeMetricsRequestModel struct {
+	// Name of the node for which to retrieve metrics
+	NodeName string `query:"node_name" example:"worker-1"`
+	// Start time for metric collection in RFC3339 format
+	StartTime string `query:"start_time" example:"2024-08-24T20:00:00.000Z"`
+	// End time for metric collection in RFC3339 format
+	EndTime string `query:"end_time" example:"2024-08-24T20:30:00.000Z"`
+}
+
 type CreateServiceRequestModel struct {
 	// Namespace for the service
 	Namespace string `json:"namespace" validate:"required" example:"default"`
@@ -112,11 +178,16 @@ type CreateServiceRequestModel struct {
 	ExternalIPs []string `json:"external_ips" example:"10.1.2.30,10.2.2.30"`
 	// Selector to match pods for the service. To create service for specific deployment use {"app": deploymentname}.
 	Selector map[string]string `json:"selector" validate:"required" example:"{\"app\": \"nginx\"}"`
+	// Only preview the changes with server-side dry run and return their diff
+	Diff bool `json:"diff" example:"false"`
 }
 
 type ListServicesRequestModel struct {
+	ListOptionsModel
 	// Namespace to filter services
 	Namespace string `query:"namespace" example:"default"`
+	// Read directly from the API server instead of the cache
+	Fresh bool `query:"fresh" example:"false"`
 }
 
 type DeleteServiceRequestModel struct {
@@ -124,4 +195,613 @@ type DeleteServiceRequestModel struct {
 	Namespace string `json:"namespace" validate:"required" example:"default"`
 	// Name of the service to delete
 	Name string `json:"name" validate:"required" example:"myservice"`
+	// Only preview the changes with server-side dry run and return their diff
+	Diff bool `json:"diff" example:"false"`
+}
+
+type IngressPathModel struct {
+	// Path matched against the request URL
+	Path string `json:"path" validate:"required" example:"/"`
+	// How the path is matched (Exact, Prefix or ImplementationSpecific, default: Prefix)
+	PathType string `json:"path_type" validate:"omitempty,oneof=Exact Prefix ImplementationSpecific" example:"Prefix"`
+	// Name of the backend service in the same namespace
+	ServiceName string `json:"service_name" validate:"required" example:"nginx-service"`
+	// Port number of the backend service, either this or service_port_name has to be set
+	ServicePort int32 `json:"service_port" validate:"required_without=ServicePortName,gte=0,lte=65535" example:"80"`
+	// Port name of the backend service
+	ServicePortName string `json:"service_port_name" validate:"required_without=ServicePort" example:"http"`
+}
+
+type IngressRuleModel struct {
+	// Host the rule applies to, empty means all hosts
+	Host string `json:"host" example:"nginx.example.com"`
+	// Paths routed to backend services
+	Paths []IngressPathModel `json:"paths" validate:"required,min=1,dive"`
+}
+
+type IngressTLSModel struct {
+	// Hosts covered by the TLS certificate
+	Hosts []string `json:"hosts" example:"nginx.example.com"`
+	// Name of the secret holding the TLS certificate and key
+	SecretName string `json:"secret_name" validate:"required" example:"nginx-tls"`
+}
+
+type ListIngressesRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter ingresses
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type CreateIngressRequestModel struct {
+	// Namespace for the ingress
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name for the ingress
+	Name string `json:"name" validate:"required" example:"nginx-ingress"`
+	// Name of the IngressClass handling the ingress, cluster default is used when empty
+	IngressClass string `json:"ingress_class" example:"nginx"`
+	// Routing rules of the ingress
+	Rules []IngressRuleModel `json:"rules" validate:"required,min=1,dive"`
+	// TLS configuration of the ingress
+	TLS []IngressTLSModel `json:"tls" validate:"dive"`
+}
+
+type UpdateIngressRequestModel struct {
+	// Namespace of the ingress
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the ingress
+	Name string `json:"name" validate:"required" example:"nginx-ingress"`
+	// Name of the IngressClass handling the ingress, cluster default is used when empty
+	IngressClass string `json:"ingress_class" example:"nginx"`
+	// Routing rules replacing the current ones
+	Rules []IngressRuleModel `json:"rules" validate:"required,min=1,dive"`
+	// TLS configuration replacing the current one
+	TLS []IngressTLSModel `json:"tls" validate:"dive"`
+}
+
+type DeleteIngressRequestModel struct {
+	// Namespace of the ingress to delete
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the ingress to delete
+	Name string `json:"name" validate:"required" example:"nginx-ingress"`
+}
+
+type ListNamespacesV2RequestModel struct {
+	ListOptionsModel
+}
+
+type CreateNamespaceRequestModel struct {
+	// Name for the namespace
+	Name string `json:"name" validate:"required" example:"payments"`
+	// Labels of the namespace
+	Labels map[string]string `json:"labels" example:"{\"team\": \"payments\"}"`
+	// Annotations of the namespace
+	Annotations map[string]string `json:"annotations" example:"{\"owner\": \"john\"}"`
+}
+
+type UpdateNamespaceRequestModel struct {
+	// Name of the namespace
+	Name string `json:"name" validate:"required" example:"payments"`
+	// Labels replacing the current ones, current labels are kept when not set
+	Labels map[string]string `json:"labels" example:"{\"team\": \"payments\"}"`
+	// Annotations replacing the current ones, current annotations are kept when not set
+	Annotations map[string]string `json:"annotations" example:"{\"owner\": \"john\"}"`
+}
+
+type DeleteNamespaceRequestModel struct {
+	// Name of the namespace to delete
+	Name string `json:"name" validate:"required" example:"payments"`
+	// Token returned by the first call without it, confirms the deletion
+	ConfirmationToken string `json:"confirmation_token" example:"1724529600.6d1c..."`
+}
+
+type ResourceQuotaModel struct {
+	// Namespace of the resource quota
+	Namespace string `json:"namespace" validate:"required" example:"payments"`
+	// Name of the resource quota
+	Name string `json:"name" validate:"required" example:"compute"`
+	// Hard limits by resource name in k8s format
+	Hard map[string]string `json:"hard" validate:"required,min=1" example:"{\"requests.cpu\": \"4\", \"pods\": \"20\"}"`
+}
+
+type ListResourceQuotasRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter resource quotas
+	Namespace string `query:"namespace" example:"payments"`
+}
+
+type DeleteResourceQuotaRequestModel struct {
+	// Namespace of the resource quota to delete
+	Namespace string `json:"namespace" validate:"required" example:"payments"`
+	// Name of the resource quota to delete
+	Name string `json:"name" validate:"required" example:"compute"`
+}
+
+type HPAModel struct {
+	// Namespace of the horizontal pod autoscaler and its target
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the horizontal pod autoscaler
+	Name string `json:"name" validate:"required" example:"nginx"`
+	// Kind of the scaled workload
+	TargetKind string `json:"target_kind" validate:"required,oneof=Deployment StatefulSet" example:"Deployment"`
+	// Name of the scaled workload
+	TargetName string `json:"target_name" validate:"required" example:"nginx"`
+	// Lowest number of replicas (default: 1)
+	MinReplicas int32 `json:"min_replicas" validate:"gte=0" example:"2"`
+	// Highest number of replicas, not lower than min_replicas
+	MaxReplicas int32 `json:"max_replicas" validate:"required,gte=1" example:"10"`
+	// Target average CPU utilization in percent of the requests, not used when 0
+	CPUUtilization int32 `json:"cpu_utilization" validate:"gte=0" example:"70"`
+	// Target average memory utilization in percent of the requests, not used when 0
+	MemoryUtilization int32 `json:"memory_utilization" validate:"gte=0" example:"80"`
+}
+
+type ListHPAsRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter horizontal pod autoscalers
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type DeleteHPARequestModel struct {
+	// Namespace of the horizontal pod autoscaler to delete
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the horizontal pod autoscaler to delete
+	Name string `json:"name" validate:"required" example:"nginx"`
+}
+
+type PDBModel struct {
+	// Namespace of the pod disruption budget and its pods
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the pod disruption budget
+	Name string `json:"name" validate:"required" example:"nginx"`
+	// Labels of the pods the budget protects
+	Selector map[string]string `json:"selector" validate:"required,min=1" example:"{\"app\": \"nginx\"}"`
+	// Pods which have to stay available, number or percentage, can't be used with max_unavailable
+	MinAvailable string `json:"min_available" example:"1"`
+	// Pods which can be unavailable, number or percentage, can't be used with min_available
+	MaxUnavailable string `json:"max_unavailable" example:"25%"`
+}
+
+type ListPDBsRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter pod disruption budgets
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type DeletePDBRequestModel struct {
+	// Namespace of the pod disruption budget to delete
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the pod disruption budget to delete
+	Name string `json:"name" validate:"required" example:"nginx"`
+}
+
+type NetworkPolicyPeerModel struct {
+	// Labels of the selected pods, {} for all pods, in the namespace of the policy unless namespace_selector is set
+	PodSelector map[string]string `json:"pod_selector" example:"{\"app\": \"web\"}"`
+	// Labels of the namespaces of the selected pods, {} for all namespaces
+	NamespaceSelector map[string]string `json:"namespace_selector" example:"{\"team\": \"payments\"}"`
+	// IP range of the peer, can't be used with selectors
+	CIDR string `json:"cidr" example:"10.0.0.0/8"`
+	// IP ranges excluded from the cidr
+	Except []string `json:"except" example:"10.1.0.0/16"`
+}
+
+type NetworkPolicyPortModel struct {
+	// Protocol of the port (default: TCP)
+	Protocol string `json:"protocol" validate:"omitempty,oneof=TCP UDP SCTP" example:"TCP"`
+	// Port number or name of the container port, all ports when empty
+	Port string `json:"port" example:"8080"`
+	// Last port of the range starting at port
+	EndPort int32 `json:"end_port" validate:"gte=0,lte=65535" example:"8090"`
+}
+
+type NetworkPolicyRuleModel struct {
+	// Allowed sources (ingress) or destinations (egress), everyone when empty
+	Peers []NetworkPolicyPeerModel `json:"peers" validate:"dive"`
+	// Allowed ports, all ports when empty
+	Ports []NetworkPolicyPortModel `json:"ports" validate:"dive"`
+}
+
+type NetworkPolicyModel struct {
+	// Namespace of the network policy
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the network policy
+	Name string `json:"name" validate:"required" example:"nginx-ingress"`
+	// Labels of the pods the policy applies to, all pods of the namespace when empty
+	PodSelector map[string]string `json:"pod_selector" example:"{\"app\": \"nginx\"}"`
+	// Directions the policy isolates (Ingress, Egress), ingress and egress when there are egress rules by default
+	PolicyTypes []string `json:"policy_types" validate:"dive,oneof=Ingress Egress" example:"Ingress"`
+	// Rules of allowed incoming connections, none allowed when empty
+	Ingress []NetworkPolicyRuleModel `json:"ingress" validate:"dive"`
+	// Rules of allowed outgoing connections, none allowed when empty
+	Egress []NetworkPolicyRuleModel `json:"egress" validate:"dive"`
+}
+
+type ListNetworkPoliciesRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter network policies
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type DeleteNetworkPolicyRequestModel struct {
+	// Namespace of the network policy to delete
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the network policy to delete
+	Name string `json:"name" validate:"required" example:"nginx-ingress"`
+}
+
+type EvaluateNetworkPolicyRequestModel struct {
+	// Namespace of the connecting pod
+	SourceNamespace string `query:"source_namespace" validate:"required" example:"default"`
+	// Name of the connecting pod
+	SourcePod string `query:"source_pod" validate:"required" example:"web-7c9f-abcde"`
+	// Namespace of the pod connected to
+	DestinationNamespace string `query:"destination_namespace" validate:"required" example:"payments"`
+	// Name of the pod connected to
+	DestinationPod string `query:"destination_pod" validate:"required" example:"api-5d8-fghij"`
+	// Port number or name of the destination container port
+	Port string `query:"port" validate:"required" example:"8080"`
+	// Protocol of the connection (default: TCP)
+	Protocol string `query:"protocol" validate:"omitempty,oneof=TCP UDP SCTP" example:"TCP"`
+}
+
+type ListRolesRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter roles
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type ListClusterRolesRequestModel struct {
+	ListOptionsModel
+}
+
+type ListRoleBindingsRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter role bindings
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type ListClusterRoleBindingsRequestModel struct {
+	ListOptionsModel
+}
+
+type ListServiceAccountsRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter service accounts
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type WhoCanRequestModel struct {
+	// Verb of the request (ex. get, list, create, delete)
+	Verb string `query:"verb" validate:"required" example:"delete"`
+	// API group of the resource, empty for the core group
+	Group string `query:"group" example:"apps"`
+	// Resource in plural form
+	Resource string `query:"resource" validate:"required" example:"deployments"`
+	// Subresource (ex. log, scale, exec)
+	Subresource string `query:"subresource" example:"scale"`
+	// Namespace of the request, cluster wide when empty
+	Namespace string `query:"namespace" example:"default"`
+	// Name of the object, any object when empty
+	Name string `query:"name" example:"nginx"`
+}
+
+type SubjectPermissionsRequestModel struct {
+	// Kind of the subject (User, Group or ServiceAccount)
+	Kind string `query:"kind" validate:"required,oneof=User Group ServiceAccount" example:"ServiceAccount"`
+	// Name of the subject
+	Name string `query:"name" validate:"required" example:"builder"`
+	// Namespace of the service account
+	Namespace string `query:"namespace" validate:"required_if=Kind ServiceAccount" example:"default"`
+	// Groups of the user, bindings to these groups are included
+	Groups []string `query:"groups" example:"developers"`
+}
+
+type AccessReviewRequestModel struct {
+	// Verb of the request (ex. get, list, create, delete)
+	Verb string `json:"verb" validate:"required" example:"create"`
+	// API group of the resource, empty for the core group
+	Group string `json:"group" example:"apps"`
+	// Resource in plural form
+	Resource string `json:"resource" validate:"required" example:"deployments"`
+	// Subresource (ex. log, scale, exec)
+	Subresource string `json:"subresource" example:"scale"`
+	// Namespace of the request, cluster wide when empty
+	Namespace string `json:"namespace" example:"default"`
+	// Name of the object, any object when empty
+	Name string `json:"name" example:"nginx"`
+}
+
+type LimitRangeItemModel struct {
+	// Kind of object the limits apply to (Container, Pod or PersistentVolumeClaim)
+	Type string `json:"type" validate:"required,oneof=Container Pod PersistentVolumeClaim" example:"Container"`
+	// Maximum usage by resource name
+	Max map[string]string `json:"max" example:"{\"cpu\": \"2\"}"`
+	// Minimum usage by resource name
+	Min map[string]string `json:"min" example:"{\"cpu\": \"50m\"}"`
+	// Default limits by resource name
+	Default map[string]string `json:"default" example:"{\"cpu\": \"500m\"}"`
+	// Default requests by resource name
+	DefaultRequest map[string]string `json:"default_request" example:"{\"cpu\": \"100m\"}"`
+}
+
+type LimitRangeModel struct {
+	// Namespace of the limit range
+	Namespace string `json:"namespace" validate:"required" example:"payments"`
+	// Name of the limit range
+	Name string `json:"name" validate:"required" example:"defaults"`
+	// Limits grouped by object kind
+	Limits []LimitRangeItemModel `json:"limits" validate:"required,min=1,dive"`
+}
+
+type ListLimitRangesRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter limit ranges
+	Namespace string `query:"namespace" example:"payments"`
+}
+
+type DeleteLimitRangeRequestModel struct {
+	// Namespace of the limit range to delete
+	Namespace string `json:"namespace" validate:"required" example:"payments"`
+	// Name of the limit range to delete
+	Name string `json:"name" validate:"required" example:"defaults"`
+}
+
+type ListNodesRequestModel struct {
+	ListOptionsModel
+}
+
+type GetNodeRequestModel struct {
+	// Name of the node
+	Name string `query:"name" validate:"required" example:"worker-1"`
+}
+
+type CordonNodeRequestModel struct {
+	// Name of the node to cordon or uncordon
+	Name string `json:"name" validate:"required" example:"worker-1"`
+}
+
+type DrainNodeRequestModel struct {
+	// Name of the node to drain
+	Name string `json:"name" validate:"required" example:"worker-1"`
+	// How long to keep retrying evictions blocked by PodDisruptionBudgets and wait for pods to terminate (default: 300)
+	TimeoutSeconds int `json:"timeout_seconds" validate:"gte=0,lte=3600" example:"300"`
+	// Evict pods not managed by a controller, they are not recreated anywhere (default: false)
+	Force bool `json:"force" example:"false"`
+	// Evict pods with emptyDir volumes, their data is deleted (default: false)
+	DeleteEmptyDirData bool `json:"delete_emptydir_data" example:"false"`
+}
+
+type GetOperationRequestModel struct {
+	// Identifier of the operation
+	ID string `query:"id" validate:"required" example:"5b8c7f0e-3a4d-4e0b-9a52-0c3d6f1e2b7a"`
+}
+
+type ListPersistentVolumeClaimsRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter persistent volume claims
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type ListPersistentVolumesRequestModel struct {
+	ListOptionsModel
+}
+
+type ListStorageClassesRequestModel struct {
+	ListOptionsModel
+}
+
+type CreatePersistentVolumeClaimRequestModel struct {
+	// Namespace for the claim
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name for the claim
+	Name string `json:"name" validate:"required" example:"data"`
+	// Name of the StorageClass, cluster default is used when empty
+	StorageClass string `json:"storage_class" example:"standard"`
+	// Access modes of the claim (default: ReadWriteOnce)
+	AccessModes []string `json:"access_modes" validate:"dive,oneof=ReadWriteOnce ReadOnlyMany ReadWriteMany ReadWriteOncePod" example:"ReadWriteOnce"`
+	// Requested size in k8s format
+	Size string `json:"size" validate:"required" example:"10Gi"`
+}
+
+type ExpandPersistentVolumeClaimRequestModel struct {
+	// Namespace of the claim
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Name of the claim
+	Name string `json:"name" validate:"required" example:"data"`
+	// New size in k8s format, has to be bigger than the current one
+	Size string `json:"size" validate:"required" example:"20Gi"`
+}
+
+type ListEventsRequestModel struct {
+	ListOptionsModel
+	// Namespace to filter events
+	Namespace string `query:"namespace" example:"default"`
+	// Kind of the involved object
+	InvolvedKind string `query:"involved_kind" example:"Pod"`
+	// Name of the involved object
+	InvolvedName string `query:"involved_name" example:"nginx-deploy-59849dcb58-tdknv"`
+	// Type of the event
+	Type string `query:"type" validate:"omitempty,oneof=Normal Warning" example:"Warning"`
+	// Reason of the event
+	Reason string `query:"reason" example:"BackOff"`
+}
+
+type GetEventHistoryRequestModel struct {
+	// Namespace to filter events
+	Namespace string `query:"namespace" example:"default"`
+	// Kind of the involved object
+	InvolvedKind string `query:"involved_kind" example:"Pod"`
+	// Name of the involved object
+	InvolvedName string `query:"involved_name" example:"nginx-deploy-59849dcb58-tdknv"`
+	// Type of the event
+	Type string `query:"type" validate:"omitempty,oneof=Normal Warning" example:"Warning"`
+	// Reason of the event
+	Reason string `query:"reason" example:"BackOff"`
+	// Start of the time range (by last occurrence) in RFC3339 format
+	StartTime string `query:"start_time" example:"2024-08-24T20:00:00.000Z"`
+	// End of the time range (by last occurrence) in RFC3339 format
+	EndTime string `query:"end_time" example:"2024-08-24T20:30:00.000Z"`
+	// Maximum number of returned events (default: 500)
+	Limit int `query:"limit" validate:"gte=0,lte=5000" example:"500"`
+}
+
+type ListResourcesRequestModel struct {
+	ListOptionsModel
+	// API group of the resource, empty for the core group
+	Group string `query:"group" example:"apps"`
+	// API version of the resource, preferred version is used when empty
+	Version string `query:"version" example:"v1"`
+	// Plural name of the resource
+	Resource string `query:"resource" validate:"required" example:"deployments"`
+	// Namespace to filter namespaced resources
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type GetResourceRequestModel struct {
+	// API group of the resource, empty for the core group
+	Group string `query:"group" example:"apps"`
+	// API version of the resource, preferred version is used when empty
+	Version string `query:"version" example:"v1"`
+	// Plural name of the resource
+	Resource string `query:"resource" validate:"required" example:"deployments"`
+	// Namespace of the object, ignored for cluster scoped resources
+	Namespace string `query:"namespace" example:"default"`
+	// Name of the object
+	Name string `query:"name" validate:"required" example:"nginx-deployment"`
+}
+
+type ExportResourceRequestModel struct {
+	// API group of the resource, empty for the core group
+	Group string `query:"group" example:"apps"`
+	// API version of the resource, preferred version is used when empty
+	Version string `query:"version" example:"v1"`
+	// Plural name of the resource
+	Resource string `query:"resource" validate:"required" example:"deployments"`
+	// Namespace of the object, ignored for cluster scoped resources
+	Namespace string `query:"namespace" example:"default"`
+	// Name of the object
+	Name string `query:"name" validate:"required" example:"nginx-deployment"`
+}
+
+type ExportNamespaceRequestModel struct {
+	// Namespace to export
+	Namespace string `query:"namespace" validate:"required" example:"default"`
+	// Archive format, tar (gzip compressed) when empty
+	Format string `query:"format" validate:"omitempty,oneof=tar zip" example:"tar"`
+}
+
+type GetResourceTreeRequestModel struct {
+	// API group of the resource, empty for the core group
+	Group string `query:"group" example:"apps"`
+	// API version of the resource, preferred version is used when empty
+	Version string `query:"version" example:"v1"`
+	// Plural name of the resource
+	Resource string `query:"resource" validate:"required" example:"pods"`
+	// Namespace of the object, ignored for cluster scoped resources
+	Namespace string `query:"namespace" example:"default"`
+	// Name of the object
+	Name string `query:"name" validate:"required" example:"nginx-deploy-59849dcb58-tdknv"`
+}
+
+type GetTopologyRequestModel struct {
+	// Namespace to build the graph for
+	Namespace string `query:"namespace" validate:"required" example:"default"`
+}
+
+type DeleteResourceRequestModel struct {
+	// API group of the resource, empty for the core group
+	Group string `json:"group" example:"apps"`
+	// API version of the resource, preferred version is used when empty
+	Version string `json:"version" example:"v1"`
+	// Plural name of the resource
+	Resource string `json:"resource" validate:"required" example:"deployments"`
+	// Namespace of the object, ignored for cluster scoped resources
+	Namespace string `json:"namespace" example:"default"`
+	// Name of the object to delete
+	Name string `json:"name" validate:"required" example:"nginx-deployment"`
+}
+
+type ApplyManifestsRequestModel struct {
+	// One or more YAML documents separated by --- or JSON objects
+	Manifest string `json:"manifest" validate:"required" example:"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  mode: prod\n"`
+	// Namespace used for namespaced objects which don't specify one (default: default)
+	Namespace string `json:"namespace" example:"default"`
+	// Set to server to only preview the result without persisting anything
+	DryRun string `json:"dry_run" validate:"omitempty,oneof=server" example:"server"`
+	// Take over fields owned by other field managers instead of failing on conflicts
+	Force bool `json:"force" example:"false"`
+	// Only preview the changes with server-side dry run and return their diff
+	Diff bool `json:"diff" example:"false"`
+}
+
+type CreateSnapshotRequestModel struct {
+	// Namespace to snapshot
+	Namespace string `json:"namespace" validate:"required" example:"default"`
+	// Label describing the snapshot
+	Label string `json:"label" validate:"required,max=100" example:"before-migration"`
+}
+
+type ListSnapshotsRequestModel struct {
+	// Only snapshots of this namespace, all when empty
+	Namespace string `query:"namespace" example:"default"`
+}
+
+type DiffSnapshotRequestModel struct {
+	// ID of the snapshot
+	ID uint `query:"id" validate:"required" example:"1"`
+}
+
+type RestoreSnapshotRequestModel struct {
+	// ID of the snapshot
+	ID uint `json:"id" validate:"required" example:"1"`
+	// Set to "server" to only preview the results with server-side dry run
+	DryRun string `json:"dry_run" validate:"omitempty,oneof=server" example:"server"`
+}
+
+type DeleteSnapshotRequestModel struct {
+	// ID of the snapshot
+	ID uint `json:"id" validate:"required" example:"1"`
+}
+
+type SearchRequestModel struct {
+	// Case insensitive substring of the object name
+	Query string `query:"query" example:"nginx"`
+	// Label selector, ex. team=payments,tier!=frontend
+	LabelSelector string `query:"label_selector" example:"team=payments"`
+	// Annotation key or key=value the objects have to have
+	Annotation string `query:"annotation" example:"prometheus.io/scrape=true"`
+	// Substring of container image used by the object
+	Image string `query:"image" example:"nginx:1.27"`
+	// Comma separated kinds to search, all supported kinds when empty
+	Kinds string `query:"kinds" example:"Pod,Deployment"`
+	// Only objects in this namespace, all namespaces when empty
+	Namespace string `query:"namespace" example:"default"`
+	// Maximum number of hits returned, 100 when empty
+	Limit int `query:"limit" validate:"omitempty,min=1,max=1000" example:"100"`
+}
+
+type WatchRequestModel struct {
+	// Comma separated resources to watch (pods, deployments, services, events), all when empty
+	Resources string `query:"resources" example:"pods,deployments"`
+	// Only changes in this namespace, all namespaces when empty
+	Namespace string `query:"namespace" example:"default"`
+	// Resume after this resource version (id of the last received event), Last-Event-ID header is used when empty
+	ResourceVersion uint64 `query:"resource_version" example:"1024"`
+}
+
+type ProxyRequestModel struct {
+	// Kind of the target, pods or services
+	Kind string `uri:"kind" example:"pods"`
+	// Namespace of the pod or service
+	Namespace string `uri:"namespace" example:"default"`
+	// Name of the pod or service
+	Name string `uri:"name" example:"nginx"`
+	// Port number or name of the container or service port
+	Port string `uri:"port" example:"8080"`
+	// Path on the target port
+	Path string `example:"metrics"`
+	// HTTP method of the request
+	Method string `example:"GET"`
+	// Raw query of the request without the cluster parameter
+	Query string `example:"format=json"`
 }
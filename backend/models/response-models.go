@@ -1,5 +1,14 @@
 package models
 
+type PaginationModel struct {
+	// Number of items in the page.
+	Count int `json:"count" example:"100"`
+	// Token to request the next page, empty on the last page.
+	Continue string `json:"continue" example:"eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTIzNDV9"`
+	// Estimated number of objects after the page, set only when the server knows it.
+	RemainingItemCount *int64 `json:"remaining_item_count,omitempty" example:"250"`
+}
+
 type ListPodsV2ResponseModelPod struct {
 	// The name of the pod.
 	Name string `json:"name" example:"nginx-deploy-59849dcb58-tdknv"`
@@ -12,6 +21,8 @@ type ListPodsV2ResponseModelPod struct {
 type ListPodsV2ResponseModel struct {
 	// A slice of ListPodsV2ResponseModelPod objects representing the pods.
 	Pods []ListPodsV2ResponseModelPod `json:"pods"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
 }
 
 type ListDeploymentsResponseModelDeployment struct {
@@ -34,6 +45,8 @@ type ListDeploymentsResponseModelDeployment struct {
 type ListDeploymentsResponseModel struct {
 	// A slice of ListDeploymentsResponseModelDeployment objects representing the deployments.
 	Deployments []ListDeploymentsResponseModelDeployment `json:"deployments"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
 }
 
 type ListContainersReponseModelPort struct {
@@ -69,6 +82,8 @@ type ListContainersReponseModelContainer struct {
 type ListContainersReponseModel struct {
 	// A list of ListContainersReponseModelContainer objects representing the containers.
 	Containers []ListContainersReponseModelContainer `json:"containers"`
+	// Pagination of the pods containing the containers, count is the number of pods.
+	Pagination PaginationModel `json:"pagination"`
 }
 
 // TODO: maybe merge with ListContainersReponseModelPort
@@ -104,4 +119,840 @@ type ListServicesResponseModelService struct {
 type ListServicesResponseModel struct {
 	// A list of ListServicesResponseModelService containing services data.
 	Services []ListServicesResponseModelService `json:"services"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ListIngressesResponseModelIngress struct {
+	// The name of the ingress.
+	Name string `json:"name" example:"nginx-ingress"`
+	// The namespace of the ingress.
+	Namespace string `json:"namespace" example:"default"`
+	// The IngressClass handling the ingress.
+	IngressClass string `json:"ingress_class" example:"nginx"`
+	// Routing rules of the ingress.
+	Rules []IngressRuleModel `json:"rules"`
+	// TLS configuration of the ingress.
+	TLS []IngressTLSModel `json:"tls"`
+	// IPs and hostnames assigned by the load balancer.
+	Addresses []string `json:"addresses" example:"192.168.49.2"`
+	// The creation time of the ingress.
+	CreationTime string `json:"creation_time" example:"2024-08-24T20:00:00.000Z"`
+}
+
+type ListIngressesResponseModel struct {
+	// A list of ListIngressesResponseModelIngress containing ingresses data.
+	Ingresses []ListIngressesResponseModelIngress `json:"ingresses"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ResourceQuotaUsageModel struct {
+	// The name of the resource.
+	Resource string `json:"resource" example:"requests.cpu"`
+	// Current usage of the resource.
+	Used string `json:"used" example:"1500m"`
+	// Hard limit of the resource.
+	Hard string `json:"hard" example:"4"`
+}
+
+type ListNamespacesV2ResponseModelNamespace struct {
+	// The name of the namespace.
+	Name string `json:"name" example:"payments"`
+	// The phase of the namespace (Active or Terminating).
+	Status string `json:"status" example:"Active"`
+	// Labels of the namespace.
+	Labels map[string]string `json:"labels" example:"{\"team\": \"payments\"}"`
+	// Annotations of the namespace.
+	Annotations map[string]string `json:"annotations" example:"{\"owner\": \"john\"}"`
+	// The creation time of the namespace.
+	CreationTime string `json:"creation_time" example:"2024-08-24T20:00:00.000Z"`
+	// Human readable age of the namespace.
+	Age string `json:"age" example:"3d4h"`
+	// Usage against hard limits of all resource quotas in the namespace, empty when the user can't list quotas.
+	Quota []ResourceQuotaUsageModel `json:"quota"`
+}
+
+type ListNamespacesV2ResponseModel struct {
+	// A list of ListNamespacesV2ResponseModelNamespace containing namespaces data.
+	Namespaces []ListNamespacesV2ResponseModelNamespace `json:"namespaces"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type DeleteNamespaceResponseModel struct {
+	// Status of the request.
+	Status string `json:"status" example:"confirmation required"`
+	// Token to send back to confirm the deletion, set only when confirmation is required.
+	ConfirmationToken string `json:"confirmation_token,omitempty" example:"1724529600.6d1c..."`
+	// Expiration time of the token.
+	ExpiresAt string `json:"expires_at,omitempty" example:"2024-08-24T20:05:00.000Z"`
+}
+
+type ListResourceQuotasResponseModelQuota struct {
+	// The name of the resource quota.
+	Name string `json:"name" example:"compute"`
+	// The namespace of the resource quota.
+	Namespace string `json:"namespace" example:"payments"`
+	// Usage against hard limits by resource.
+	Usage []ResourceQuotaUsageModel `json:"usage"`
+}
+
+type ListResourceQuotasResponseModel struct {
+	// A list of ListResourceQuotasResponseModelQuota containing resource quotas data.
+	ResourceQuotas []ListResourceQuotasResponseModelQuota `json:"resource_quotas"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type HPAMetricModel struct {
+	// Type of the metric source (Resource, ContainerResource, Pods, Object or External).
+	Type string `json:"type" example:"Resource"`
+	// The name of the resource or metric.
+	Name string `json:"name" example:"cpu"`
+	// Average utilization in percent of the requests, set for utilization targets.
+	Utilization *int32 `json:"utilization,omitempty" example:"70"`
+	// Average value per pod, set for average value targets.
+	AverageValue string `json:"average_value,omitempty" example:"500m"`
+	// Total value, set for value targets.
+	Value string `json:"value,omitempty" example:"100"`
+}
+
+type ListHPAsResponseModelHPA struct {
+	// The name of the horizontal pod autoscaler.
+	Name string `json:"name" example:"nginx"`
+	// The namespace of the horizontal pod autoscaler.
+	Namespace string `json:"namespace" example:"default"`
+	// Kind of the scaled workload.
+	TargetKind string `json:"target_kind" example:"Deployment"`
+	// The name of the scaled workload.
+	TargetName string `json:"target_name" example:"nginx"`
+	// Lowest number of replicas.
+	MinReplicas int32 `json:"min_replicas" example:"2"`
+	// Highest number of replicas.
+	MaxReplicas int32 `json:"max_replicas" example:"10"`
+	// Number of replicas the workload has now.
+	CurrentReplicas int32 `json:"current_replicas" example:"3"`
+	// Number of replicas the autoscaler wants.
+	DesiredReplicas int32 `json:"desired_replicas" example:"4"`
+	// Metric targets of the autoscaler.
+	Targets []HPAMetricModel `json:"targets"`
+	// Current values of the metrics, empty until the autoscaler reads them.
+	Current []HPAMetricModel `json:"current"`
+	// Time of the last scaling, empty when it never scaled.
+	LastScaleTime string `json:"last_scale_time" example:"2024-08-24T20:00:00Z"`
+}
+
+type ListHPAsResponseModel struct {
+	// A list of ListHPAsResponseModelHPA containing horizontal pod autoscalers data.
+	HPAs []ListHPAsResponseModelHPA `json:"hpas"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type UpdateDeploymentResponseModel struct {
+	// Status of the request.
+	Status string `json:"status" example:"deployment updated"`
+	// Warnings about the update, ex. replicas managed by an autoscaler.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type ListPDBsResponseModelPDB struct {
+	// The name of the pod disruption budget.
+	Name string `json:"name" example:"nginx"`
+	// The namespace of the pod disruption budget.
+	Namespace string `json:"namespace" example:"default"`
+	// Label query of the protected pods.
+	Selector string `json:"selector" example:"app=nginx"`
+	// Pods which have to stay available, empty when max_unavailable is used.
+	MinAvailable string `json:"min_available" example:"1"`
+	// Pods which can be unavailable, empty when min_available is used.
+	MaxUnavailable string `json:"max_unavailable" example:"25%"`
+	// Number of healthy pods.
+	CurrentHealthy int32 `json:"current_healthy" example:"3"`
+	// Number of healthy pods the budget requires.
+	DesiredHealthy int32 `json:"desired_healthy" example:"2"`
+	// Number of pods the budget counts with.
+	ExpectedPods int32 `json:"expected_pods" example:"3"`
+	// Number of pods which can be evicted now.
+	DisruptionsAllowed int32 `json:"disruptions_allowed" example:"1"`
+}
+
+type ListPDBsResponseModel struct {
+	// A list of ListPDBsResponseModelPDB containing pod disruption budgets data.
+	PDBs []ListPDBsResponseModelPDB `json:"pdbs"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type NetworkPolicyRuleSummaryModel struct {
+	// Allowed peers, ex. "pods app=web in namespaces team=payments" or "ip 10.0.0.0/8", everyone when empty.
+	Peers []string `json:"peers" example:"pods app=web"`
+	// Allowed ports as protocol/port, ex. "TCP/8080" or "TCP/8000-8090", all ports when empty.
+	Ports []string `json:"ports" example:"TCP/8080"`
+}
+
+type ListNetworkPoliciesResponseModelPolicy struct {
+	// The name of the network policy.
+	Name string `json:"name" example:"nginx-ingress"`
+	// The namespace of the network policy.
+	Namespace string `json:"namespace" example:"default"`
+	// Label query of the pods the policy applies to.
+	PodSelector string `json:"pod_selector" example:"app=nginx"`
+	// Directions the policy isolates.
+	PolicyTypes []string `json:"policy_types" example:"Ingress"`
+	// Rules of allowed incoming connections.
+	Ingress []NetworkPolicyRuleSummaryModel `json:"ingress"`
+	// Rules of allowed outgoing connections.
+	Egress []NetworkPolicyRuleSummaryModel `json:"egress"`
+}
+
+type ListNetworkPoliciesResponseModel struct {
+	// A list of ListNetworkPoliciesResponseModelPolicy containing network policies data.
+	NetworkPolicies []ListNetworkPoliciesResponseModelPolicy `json:"network_policies"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type NetworkPolicyVerdictModel struct {
+	// Whether policies of the direction allow the connection.
+	Allowed bool `json:"allowed" example:"true"`
+	// Whether any policy of the direction selects the pod, without it everything is allowed.
+	Isolated bool `json:"isolated" example:"true"`
+	// Policies of the direction selecting the pod.
+	Policies []string `json:"policies" example:"default-deny,nginx-ingress"`
+	// Policies with a rule allowing the connection.
+	AllowedBy []string `json:"allowed_by" example:"nginx-ingress"`
+	// Human readable explanation of the verdict.
+	Reason string `json:"reason" example:"allowed by nginx-ingress"`
+}
+
+type EvaluateNetworkPolicyResponseModel struct {
+	// Whether the connection is allowed, both egress and ingress have to allow it.
+	Allowed bool `json:"allowed" example:"true"`
+	// Destination port number, named ports are resolved.
+	Port int32 `json:"port" example:"8080"`
+	// Protocol of the connection.
+	Protocol string `json:"protocol" example:"TCP"`
+	// Verdict of the egress policies of the source pod.
+	Egress NetworkPolicyVerdictModel `json:"egress"`
+	// Verdict of the ingress policies of the destination pod.
+	Ingress NetworkPolicyVerdictModel `json:"ingress"`
+}
+
+type RBACRuleModel struct {
+	// Allowed verbs, "*" for all.
+	Verbs []string `json:"verbs" example:"get,list,watch"`
+	// API groups of the resources, "" for the core group and "*" for all.
+	APIGroups []string `json:"api_groups" example:""`
+	// Resources and subresources (ex. pods/log), "*" for all.
+	Resources []string `json:"resources" example:"pods"`
+	// Names of the allowed objects, all objects when empty.
+	ResourceNames []string `json:"resource_names,omitempty" example:"nginx"`
+	// Allowed non resource URLs (ex. /healthz), set only in cluster roles.
+	NonResourceURLs []string `json:"non_resource_urls,omitempty" example:"/healthz"`
+}
+
+type ListRolesResponseModelRole struct {
+	// The name of the role.
+	Name string `json:"name" example:"pod-reader"`
+	// The namespace of the role, empty for cluster roles.
+	Namespace string `json:"namespace,omitempty" example:"default"`
+	// Rules of the role, including rules aggregated into cluster roles.
+	Rules []RBACRuleModel `json:"rules"`
+}
+
+type ListRolesResponseModel struct {
+	// A list of ListRolesResponseModelRole containing roles or cluster roles data.
+	Roles []ListRolesResponseModelRole `json:"roles"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type RBACSubjectModel struct {
+	// Kind of the subject (User, Group or ServiceAccount).
+	Kind string `json:"kind" example:"ServiceAccount"`
+	// The name of the subject.
+	Name string `json:"name" example:"builder"`
+	// The namespace of the service account.
+	Namespace string `json:"namespace,omitempty" example:"default"`
+}
+
+type ListRoleBindingsResponseModelBinding struct {
+	// The name of the binding.
+	Name string `json:"name" example:"read-pods"`
+	// The namespace of the binding, empty for cluster role bindings.
+	Namespace string `json:"namespace,omitempty" example:"default"`
+	// Kind of the bound role (Role or ClusterRole).
+	RoleKind string `json:"role_kind" example:"ClusterRole"`
+	// The name of the bound role.
+	RoleName string `json:"role_name" example:"pod-reader"`
+	// Subjects granted the role.
+	Subjects []RBACSubjectModel `json:"subjects"`
+}
+
+type ListRoleBindingsResponseModel struct {
+	// A list of ListRoleBindingsResponseModelBinding containing role bindings or cluster role bindings data.
+	Bindings []ListRoleBindingsResponseModelBinding `json:"bindings"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ListServiceAccountsResponseModelServiceAccount struct {
+	// The name of the service account.
+	Name string `json:"name" example:"builder"`
+	// The namespace of the service account.
+	Namespace string `json:"namespace" example:"default"`
+	// Names of the secrets referenced by the service account.
+	Secrets []string `json:"secrets" example:"builder-token"`
+	// Names of the image pull secrets of the service account.
+	ImagePullSecrets []string `json:"image_pull_secrets" example:"registry"`
+	// Whether the API token is mounted into pods, set only when the service account decides it.
+	AutomountToken *bool `json:"automount_token,omitempty" example:"false"`
+}
+
+type ListServiceAccountsResponseModel struct {
+	// A list of ListServiceAccountsResponseModelServiceAccount containing service accounts data.
+	ServiceAccounts []ListServiceAccountsResponseModelServiceAccount `json:"service_accounts"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type RBACGrantModel struct {
+	// The namespace the grant applies to, empty for cluster wide grants.
+	Namespace string `json:"namespace,omitempty" example:"default"`
+	// Kind of the binding (RoleBinding or ClusterRoleBinding).
+	BindingKind string `json:"binding_kind" example:"RoleBinding"`
+	// The name of the binding.
+	BindingName string `json:"binding_name" example:"read-pods"`
+	// Kind of the bound role (Role or ClusterRole).
+	RoleKind string `json:"role_kind" example:"ClusterRole"`
+	// The name of the bound role.
+	RoleName string `json:"role_name" example:"pod-reader"`
+}
+
+type WhoCanResponseModelSubject struct {
+	// Kind of the subject (User, Group or ServiceAccount).
+	Kind string `json:"kind" example:"ServiceAccount"`
+	// The name of the subject.
+	Name string `json:"name" example:"builder"`
+	// The namespace of the service account.
+	Namespace string `json:"namespace,omitempty" example:"default"`
+	// Bindings granting the subject the request.
+	GrantedBy []RBACGrantModel `json:"granted_by"`
+}
+
+type WhoCanResponseModel struct {
+	// Subjects bound to a role allowing the request, sorted by kind, namespace and name.
+	Subjects []WhoCanResponseModelSubject `json:"subjects"`
+}
+
+type SubjectPermissionsResponseModelPermission struct {
+	// Binding granting the rules.
+	Grant RBACGrantModel `json:"grant"`
+	// Subject of the binding matching the requested subject, ex. one of its groups.
+	BoundSubject RBACSubjectModel `json:"bound_subject"`
+	// Rules of the bound role.
+	Rules []RBACRuleModel `json:"rules"`
+}
+
+type SubjectPermissionsResponseModel struct {
+	// Groups of the subject considered, including groups every service account and authenticated user has.
+	Groups []string `json:"groups" example:"system:serviceaccounts,system:authenticated"`
+	// Permissions granted to the subject by the bindings, cluster wide ones first.
+	Permissions []SubjectPermissionsResponseModelPermission `json:"permissions"`
+}
+
+type AccessReviewResponseModel struct {
+	// Whether the request is allowed.
+	Allowed bool `json:"allowed" example:"true"`
+	// Whether the request is explicitly denied, set by some authorizers.
+	Denied bool `json:"denied,omitempty" example:"false"`
+	// Reason of the decision given by the authorizer.
+	Reason string `json:"reason,omitempty" example:"RBAC: allowed by ClusterRoleBinding \"admins\""`
+	// Error of the authorizer, the request may be allowed by rules it couldn't evaluate.
+	EvaluationError string `json:"evaluation_error,omitempty"`
+}
+
+type WorkloadMetricsIntervalModel struct {
+	// Start of the interval.
+	Start string `json:"start" example:"2024-08-24T20:00:00Z"`
+	// Number of metrics collections in the interval.
+	Samples int `json:"samples" example:"12"`
+	// Highest number of pods in one collection.
+	Pods int `json:"pods" example:"3"`
+	// CPU usage of all pods together in millicores, averaged over the collections.
+	CPUSum int64 `json:"cpu_sum" example:"450"`
+	// CPU usage of one pod in millicores, averaged over the pods and collections.
+	CPUAvg int64 `json:"cpu_avg" example:"150"`
+	// Highest CPU usage of one pod in millicores.
+	CPUMax int64 `json:"cpu_max" example:"210"`
+	// Memory usage of all pods together in bytes, averaged over the collections.
+	MemorySum int64 `json:"memory_sum" example:"402653184"`
+	// Memory usage of one pod in bytes, averaged over the pods and collections.
+	MemoryAvg int64 `json:"memory_avg" example:"134217728"`
+	// Highest memory usage of one pod in bytes.
+	MemoryMax int64 `json:"memory_max" example:"157286400"`
+}
+
+type WorkloadMetricsModel struct {
+	// The namespace of the pods.
+	Namespace string `json:"namespace" example:"default"`
+	// Kind of the workload, empty when grouped by namespace.
+	Kind string `json:"kind,omitempty" example:"Deployment"`
+	// The name of the workload, empty when grouped by namespace.
+	Name string `json:"name,omitempty" example:"nginx-deployment"`
+	// Usage in the intervals with collected metrics, oldest first.
+	Intervals []WorkloadMetricsIntervalModel `json:"intervals"`
+}
+
+type GetWorkloadMetricsResponseModel struct {
+	// Length of the intervals.
+	Interval string `json:"interval" example:"5m0s"`
+	// Aggregated usage of the workloads or namespaces, sorted by namespace, kind and name.
+	Workloads []WorkloadMetricsModel `json:"workloads"`
+}
+
+type ListLimitRangesResponseModel struct {
+	// A list of LimitRangeModel containing limit ranges data.
+	LimitRanges []LimitRangeModel `json:"limit_ranges"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type NodeResourcesModel struct {
+	// CPU amount in k8s format.
+	CPU string `json:"cpu" example:"4"`
+	// Memory amount in k8s format.
+	Memory string `json:"memory" example:"16318368Ki"`
+	// Number of pods, not set for requested resources.
+	Pods string `json:"pods,omitempty" example:"110"`
+}
+
+type NodeTaintModel struct {
+	// The taint key.
+	Key string `json:"key" example:"node-role.kubernetes.io/control-plane"`
+	// The taint value.
+	Value string `json:"value" example:""`
+	// The effect on pods not tolerating the taint.
+	Effect string `json:"effect" example:"NoSchedule"`
+}
+
+type NodeConditionModel struct {
+	// Type of the condition.
+	Type string `json:"type" example:"MemoryPressure"`
+	// Status of the condition (True, False or Unknown).
+	Status string `json:"status" example:"False"`
+	// Machine readable reason of the last transition.
+	Reason string `json:"reason" example:"KubeletHasSufficientMemory"`
+	// Human readable details of the last transition.
+	Message string `json:"message" example:"kubelet has sufficient memory available"`
+	// The time of the last transition.
+	LastTransitionTime string `json:"last_transition_time" example:"2024-08-24T20:00:00.000Z"`
+}
+
+type ListNodesResponseModelNode struct {
+	// The name of the node.
+	Name string `json:"name" example:"worker-1"`
+	// Readiness of the node (Ready, NotReady or Unknown).
+	Status string `json:"status" example:"Ready"`
+	// Whether the node is cordoned.
+	Unschedulable bool `json:"unschedulable" example:"false"`
+	// Roles taken from node-role.kubernetes.io labels.
+	Roles []string `json:"roles" example:"control-plane"`
+	// The version of the kubelet.
+	KubeletVersion string `json:"kubelet_version" example:"v1.31.0"`
+	// Total resources of the node.
+	Capacity NodeResourcesModel `json:"capacity"`
+	// Resources available for pods.
+	Allocatable NodeResourcesModel `json:"allocatable"`
+	// Sum of resource requests of pods running on the node.
+	Requested NodeResourcesModel `json:"requested"`
+	// Number of non terminated pods on the node.
+	PodCount int `json:"pod_count" example:"12"`
+	// Taints of the node.
+	Taints []NodeTaintModel `json:"taints"`
+	// Conditions reported by the kubelet.
+	Conditions []NodeConditionModel `json:"conditions"`
+	// The creation time of the node.
+	CreationTime string `json:"creation_time" example:"2024-08-24T20:00:00.000Z"`
+}
+
+type ListNodesResponseModel struct {
+	// A list of ListNodesResponseModelNode containing nodes data.
+	Nodes []ListNodesResponseModelNode `json:"nodes"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type GetNodeResponseModel struct {
+	ListNodesResponseModelNode
+	// Operating system image of the node.
+	OSImage string `json:"os_image" example:"Ubuntu 22.04.4 LTS"`
+	// Container runtime of the node.
+	ContainerRuntime string `json:"container_runtime" example:"containerd://1.7.19"`
+	// Addresses of the node by type.
+	Addresses map[string]string `json:"addresses" example:"{\"InternalIP\": \"192.168.49.2\"}"`
+	// Pods scheduled on the node.
+	Pods []ListPodsV2ResponseModelPod `json:"pods"`
+}
+
+type OperationItemModel struct {
+	// The name of the object the item is about.
+	Name string `json:"name" example:"nginx-deploy-59849dcb58-tdknv"`
+	// The namespace of the object.
+	Namespace string `json:"namespace" example:"default"`
+	// Progress of the item (Pending, Running, Succeeded, Skipped or Failed).
+	Status string `json:"status" example:"Succeeded"`
+	// Details of the progress.
+	Message string `json:"message" example:"evicted"`
+}
+
+type OperationModel struct {
+	// Identifier of the operation.
+	ID string `json:"id" example:"5b8c7f0e-3a4d-4e0b-9a52-0c3d6f1e2b7a"`
+	// Name of the cluster the operation runs in.
+	Cluster string `json:"cluster" example:"production"`
+	// Kind of the operation.
+	Kind string `json:"kind" example:"drain"`
+	// The object the operation works on.
+	Target string `json:"target" example:"node/worker-1"`
+	// State of the operation (Running, Succeeded or Failed).
+	Status string `json:"status" example:"Running"`
+	// Error of failed operation.
+	Error string `json:"error,omitempty" example:"timed out waiting for evictions"`
+	// The start time of the operation.
+	StartTime string `json:"start_time" example:"2024-08-24T20:00:00.000Z"`
+	// The end time of the operation, empty while running.
+	EndTime string `json:"end_time,omitempty" example:"2024-08-24T20:01:00.000Z"`
+	// Progress of the operation per object.
+	Items []OperationItemModel `json:"items"`
+}
+
+type ListOperationsResponseModel struct {
+	// A list of OperationModel containing background operations.
+	Operations []OperationModel `json:"operations"`
+}
+
+type ListPersistentVolumeClaimsResponseModelClaim struct {
+	// The name of the claim.
+	Name string `json:"name" example:"data"`
+	// The namespace of the claim.
+	Namespace string `json:"namespace" example:"default"`
+	// The phase of the claim (Pending, Bound or Lost).
+	Status string `json:"status" example:"Bound"`
+	// The name of the bound persistent volume.
+	Volume string `json:"volume" example:"pvc-0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"`
+	// Requested size of the claim.
+	Requested string `json:"requested" example:"10Gi"`
+	// Actual capacity of the bound volume.
+	Capacity string `json:"capacity" example:"10Gi"`
+	// Access modes of the claim.
+	AccessModes []string `json:"access_modes" example:"ReadWriteOnce"`
+	// The StorageClass of the claim.
+	StorageClass string `json:"storage_class" example:"standard"`
+	// Names of the pods mounting the claim.
+	MountedBy []string `json:"mounted_by" example:"postgres-0"`
+	// The creation time of the claim.
+	CreationTime string `json:"creation_time" example:"2024-08-24T20:00:00.000Z"`
+}
+
+type ListPersistentVolumeClaimsResponseModel struct {
+	// A list of ListPersistentVolumeClaimsResponseModelClaim containing claims data.
+	PersistentVolumeClaims []ListPersistentVolumeClaimsResponseModelClaim `json:"persistent_volume_claims"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ListPersistentVolumesResponseModelVolume struct {
+	// The name of the volume.
+	Name string `json:"name" example:"pvc-0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"`
+	// The phase of the volume (Available, Bound, Released or Failed).
+	Status string `json:"status" example:"Bound"`
+	// Capacity of the volume.
+	Capacity string `json:"capacity" example:"10Gi"`
+	// Access modes of the volume.
+	AccessModes []string `json:"access_modes" example:"ReadWriteOnce"`
+	// What happens with the volume when it's released from its claim.
+	ReclaimPolicy string `json:"reclaim_policy" example:"Delete"`
+	// The StorageClass of the volume.
+	StorageClass string `json:"storage_class" example:"standard"`
+	// The claim bound to the volume in namespace/name format.
+	Claim string `json:"claim" example:"default/data"`
+	// The creation time of the volume.
+	CreationTime string `json:"creation_time" example:"2024-08-24T20:00:00.000Z"`
+}
+
+type ListPersistentVolumesResponseModel struct {
+	// A list of ListPersistentVolumesResponseModelVolume containing volumes data.
+	PersistentVolumes []ListPersistentVolumesResponseModelVolume `json:"persistent_volumes"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ListStorageClassesResponseModelClass struct {
+	// The name of the StorageClass.
+	Name string `json:"name" example:"standard"`
+	// The provisioner creating volumes of the class.
+	Provisioner string `json:"provisioner" example:"rancher.io/local-path"`
+	// Reclaim policy of volumes created by the class.
+	ReclaimPolicy string `json:"reclaim_policy" example:"Delete"`
+	// When volumes are bound (Immediate or WaitForFirstConsumer).
+	VolumeBindingMode string `json:"volume_binding_mode" example:"WaitForFirstConsumer"`
+	// Whether claims of the class can be expanded.
+	AllowVolumeExpansion bool `json:"allow_volume_expansion" example:"true"`
+	// Whether the class is the cluster default.
+	Default bool `json:"default" example:"true"`
+}
+
+type ListStorageClassesResponseModel struct {
+	// A list of ListStorageClassesResponseModelClass containing storage classes data.
+	StorageClasses []ListStorageClassesResponseModelClass `json:"storage_classes"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ListEventsResponseModel struct {
+	// A list of events, most recent first.
+	Events []DBEventModel `json:"events"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type APIResourceModel struct {
+	// API group of the resource, empty for the core group.
+	Group string `json:"group" example:"apps"`
+	// Preferred API version of the resource.
+	Version string `json:"version" example:"v1"`
+	// Plural name of the resource.
+	Resource string `json:"resource" example:"deployments"`
+	// Kind of the objects.
+	Kind string `json:"kind" example:"Deployment"`
+	// Whether the objects live in namespaces.
+	Namespaced bool `json:"namespaced" example:"true"`
+	// Verbs supported by the resource.
+	Verbs []string `json:"verbs" example:"get,list,delete"`
+}
+
+type ListAPIResourcesResponseModel struct {
+	// A list of APIResourceModel containing all resources served by the cluster.
+	Resources []APIResourceModel `json:"resources"`
+}
+
+type ResourceTableColumnModel struct {
+	// Human readable name of the column.
+	Name string `json:"name" example:"Ready"`
+	// Type of the cells (string, integer, number, boolean or date).
+	Type string `json:"type" example:"string"`
+	// Description of the column.
+	Description string `json:"description" example:"Number of the pod with ready state"`
+	// Columns with priority above 0 are less important and may be hidden.
+	Priority int32 `json:"priority" example:"0"`
+}
+
+type ResourceTableRowModel struct {
+	// The name of the object.
+	Name string `json:"name" example:"nginx-deployment"`
+	// The namespace of the object, empty for cluster scoped resources.
+	Namespace string `json:"namespace" example:"default"`
+	// Values of the row in order of the columns.
+	Cells []interface{} `json:"cells"`
+	// The full object as returned by the API server.
+	Object map[string]interface{} `json:"object"`
+}
+
+type ListResourcesResponseModel struct {
+	// Columns of the table as defined by the server (additionalPrinterColumns for CRDs).
+	Columns []ResourceTableColumnModel `json:"columns"`
+	// Rows of the table, one per object.
+	Rows []ResourceTableRowModel `json:"rows"`
+	// Pagination of the list.
+	Pagination PaginationModel `json:"pagination"`
+}
+
+type ApplyManifestsResponseModelObject struct {
+	// API version of the object.
+	APIVersion string `json:"api_version" example:"v1"`
+	// Kind of the object.
+	Kind string `json:"kind" example:"ConfigMap"`
+	// The name of the object.
+	Name string `json:"name" example:"settings"`
+	// The namespace of the object, empty for cluster scoped resources.
+	Namespace string `json:"namespace" example:"default"`
+	// Outcome of the apply (created, configured, unchanged or error).
+	Result string `json:"result" example:"created"`
+	// Reason of the failure when result is error.
+	Error string `json:"error,omitempty" example:"namespaces \"staging\" not found"`
+	// Changes the apply would make, set only when diff was requested.
+	Diff *DiffModel `json:"diff,omitempty"`
+}
+
+type ApplyManifestsResponseModel struct {
+	// Whether the changes were only previewed.
+	DryRun bool `json:"dry_run" example:"false"`
+	// Results in the order of objects in the manifest.
+	Objects []ApplyManifestsResponseModelObject `json:"objects"`
+}
+
+type DiffChangeModel struct {
+	// Path of the changed field, ex. spec.template.spec.containers[0].image.
+	Path string `json:"path" example:"spec.replicas"`
+	// Type of the change (added, removed or changed).
+	Type string `json:"type" example:"changed"`
+	// Value before the change.
+	Old interface{} `json:"old,omitempty"`
+	// Value after the change.
+	New interface{} `json:"new,omitempty"`
+}
+
+type DiffModel struct {
+	// Kind of the object.
+	Kind string `json:"kind" example:"Deployment"`
+	// The name of the object.
+	Name string `json:"name" example:"nginx-deployment"`
+	// The namespace of the object, empty for cluster scoped resources.
+	Namespace string `json:"namespace" example:"default"`
+	// Changed fields, managedFields and status are ignored.
+	Changes []DiffChangeModel `json:"changes"`
+	// The same changes as unified diff of the YAML representation.
+	Unified string `json:"unified" example:"--- live/default/deployment/nginx-deployment\n+++ result/default/deployment/nginx-deployment\n@@ -10,3 +10,3 @@\n spec:\n-  replicas: 2\n+  replicas: 3\n"`
+	// Warnings about the changes, ex. replicas managed by an autoscaler.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type SnapshotModel struct {
+	// ID of the snapshot.
+	ID uint `json:"id" example:"1"`
+	// Name of the cluster the snapshot was taken in.
+	Cluster string `json:"cluster" example:"production"`
+	// Namespace the snapshot was taken of.
+	Namespace string `json:"namespace" example:"default"`
+	// Label given to the snapshot.
+	Label string `json:"label" example:"before-migration"`
+	// The time the snapshot was taken.
+	CreatedAt string `json:"created_at" example:"2024-08-24T20:00:00Z"`
+	// Number of objects in the snapshot.
+	ObjectCount int `json:"object_count" example:"12"`
+}
+
+type ListSnapshotsResponseModel struct {
+	// Snapshots, most recent first.
+	Snapshots []SnapshotModel `json:"snapshots"`
+}
+
+type SnapshotObjectRefModel struct {
+	// Kind of the object.
+	Kind string `json:"kind" example:"ConfigMap"`
+	// The name of the object.
+	Name string `json:"name" example:"app-config"`
+}
+
+type DiffSnapshotResponseModel struct {
+	// The snapshot compared with the live state.
+	Snapshot SnapshotModel `json:"snapshot"`
+	// Changes restore would make, only objects which differ are included.
+	Objects []DiffModel `json:"objects"`
+	// Objects created after the snapshot, restore leaves them untouched.
+	NotInSnapshot []SnapshotObjectRefModel `json:"not_in_snapshot"`
+}
+
+type GraphNodeModel struct {
+	// Unique ID of the node, UID of the object or missing/<kind>/<name> for objects which are referenced but weren't found.
+	ID string `json:"id" example:"3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"`
+	// API version of the object.
+	APIVersion string `json:"api_version" example:"apps/v1"`
+	// Kind of the object.
+	Kind string `json:"kind" example:"Deployment"`
+	// The name of the object.
+	Name string `json:"name" example:"nginx-deployment"`
+	// The namespace of the object, empty for cluster scoped resources.
+	Namespace string `json:"namespace" example:"default"`
+	// Short status, ex. pod phase, ready replicas of workloads, Missing or Unknown (kind the user can't list).
+	Status string `json:"status" example:"2/3 ready"`
+}
+
+type GraphEdgeModel struct {
+	// ID of the node the edge starts at.
+	Source string `json:"source" example:"3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"`
+	// ID of the node the edge leads to.
+	Target string `json:"target" example:"9a8b7c6d-5e4f-3a2b-1c0d-e9f8a7b6c5d4"`
+	// Relation of the nodes, one of owns, routes, selects, mounts and references.
+	Type string `json:"type" example:"owns"`
+}
+
+type ResourceTreeResponseModel struct {
+	// ID of the top level owner the tree starts at.
+	Root string `json:"root" example:"3f2a1b4c-5d6e-7f80-91a2-b3c4d5e6f708"`
+	// ID of the requested object.
+	Target string `json:"target" example:"9a8b7c6d-5e4f-3a2b-1c0d-e9f8a7b6c5d4"`
+	// Objects of the tree.
+	Nodes []GraphNodeModel `json:"nodes"`
+	// Ownership relations, from owner to dependent.
+	Edges []GraphEdgeModel `json:"edges"`
+}
+
+type TopologyResponseModel struct {
+	// Objects of the namespace.
+	Nodes []GraphNodeModel `json:"nodes"`
+	// Relations between the objects.
+	Edges []GraphEdgeModel `json:"edges"`
+}
+
+type SearchHitModel struct {
+	// Kind of the object.
+	Kind string `json:"kind" example:"Deployment"`
+	// The name of the object.
+	Name string `json:"name" example:"nginx-deployment"`
+	// The namespace of the object, empty for cluster scoped resources.
+	Namespace string `json:"namespace" example:"default"`
+	// Labels of the object.
+	Labels map[string]string `json:"labels"`
+	// Container images used by the object, empty for kinds without pods.
+	Images []string `json:"images" example:"nginx:1.27"`
+	// Criteria the object matched: name, label, annotation and image.
+	Matches []string `json:"matches" example:"name,image"`
+}
+
+type SearchResponseModel struct {
+	// Number of all matching objects, hits are limited.
+	Total int `json:"total" example:"3"`
+	// Matching objects ordered by kind, namespace and name.
+	Hits []SearchHitModel `json:"hits"`
+}
+
+type WatchEventModel struct {
+	// Version of the change, send it back as resource_version (or Last-Event-ID) to resume.
+	ResourceVersion uint64 `json:"resource_version" example:"1024"`
+	// Type of the change, one of ADDED, MODIFIED and DELETED.
+	Type string `json:"type" example:"MODIFIED"`
+	// Kind of the object, one of Pod, Deployment, Service and Event.
+	Kind string `json:"kind" example:"Pod"`
+	// The namespace of the object.
+	Namespace string `json:"namespace" example:"default"`
+	// Object summarized the same way as by the list endpoints (ex. ListPodsV2ResponseModelPod).
+	Object interface{} `json:"object"`
+}
+
+type ClusterModel struct {
+	// Name of the cluster used in the cluster parameter.
+	Name string `json:"name" example:"production"`
+	// Kubeconfig context of the cluster.
+	Context string `json:"context" example:"admin@production"`
+	// Address of the API server.
+	Server string `json:"server" example:"https://10.0.0.1:6443"`
+	// Whether requests without the cluster parameter go to this cluster.
+	Default bool `json:"default" example:"true"`
+	// Whether the API server answered.
+	Reachable bool `json:"reachable" example:"true"`
+	// Whether the API server reports it's ready (/readyz).
+	Healthy bool `json:"healthy" example:"true"`
+	// Kubernetes version of the API server, empty when unreachable.
+	Version string `json:"version" example:"v1.31.0"`
+	// Why the cluster is unreachable or unhealthy.
+	Error string `json:"error,omitempty" example:"dial tcp 10.0.0.1:6443: i/o timeout"`
+}
+
+type ListClustersResponseModel struct {
+	// A list of ClusterModel containing all configured clusters.
+	Clusters []ClusterModel `json:"clusters"`
 }